@@ -0,0 +1,107 @@
+// Copyright (C) 2014-15 Michail Kargakis
+// This source code is subject to the terms
+// of the MIT License
+
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/kargakis/cryptowallet/wallet"
+)
+
+// walletFiles returns the name of the pdf holding each of count
+// wallets. Separate wallets are numbered from 1 and zero-padded so
+// they sort in order of generation.
+func walletFiles(count int) []string {
+	files := make([]string, count)
+	width := len(strconv.Itoa(count))
+	for i := range files {
+		if conf.Separate {
+			files[i] = fmt.Sprintf("wallet-%0*d.pdf", width, i+1)
+		} else {
+			files[i] = walletFile
+		}
+	}
+	return files
+}
+
+// manifestEntry describes a generated wallet for import into other
+// systems. The private key is only set on request.
+type manifestEntry struct {
+	Index        int    `json:"index"`
+	File         string `json:"file,omitempty"`
+	Page         int    `json:"page,omitempty"`
+	Coin         string `json:"coin"`
+	Network      string `json:"network"`
+	AddressType  string `json:"address_type"`
+	Address      string `json:"address"`
+	CashAddr     string `json:"cashaddr,omitempty"`
+	Confirmation string `json:"confirmation,omitempty"`
+	PrivateKey   string `json:"private_key,omitempty"`
+}
+
+var manifestHeader = []string{"index", "file", "page", "coin", "network", "address_type", "address", "cashaddr", "confirmation", "private_key"}
+
+func (e *manifestEntry) record() []string {
+	page := ""
+	if e.Page > 0 {
+		page = strconv.Itoa(e.Page)
+	}
+	return []string{strconv.Itoa(e.Index), e.File, page, e.Coin, e.Network, e.AddressType, e.Address, e.CashAddr, e.Confirmation, e.PrivateKey}
+}
+
+// writeManifest writes the addresses of keys to name, as JSON if name
+// has a .json extension and as CSV otherwise.
+func writeManifest(name string, keys []*wallet.PrivKey, files []string) {
+	net := "mainnet"
+	if network.Testnet {
+		net = "testnet"
+	}
+	entries := make([]*manifestEntry, len(keys))
+	for i, pk := range keys {
+		entries[i] = &manifestEntry{
+			Index:        i + 1,
+			Coin:         network.Coin,
+			Network:      net,
+			AddressType:  string(pk.Address().Type()),
+			Address:      pk.Address().String(),
+			CashAddr:     pk.Address().CashAddr(),
+			Confirmation: pk.Confirmation(),
+		}
+		if !conf.DumpString {
+			entries[i].File = files[i]
+			entries[i].Page = 1
+			if !conf.Separate {
+				entries[i].Page = i/layout.PerPage() + 1
+			}
+		}
+		if conf.ManifestPrivate {
+			entries[i].PrivateKey = pk.String()
+		}
+	}
+
+	f, err := os.OpenFile(name, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+	debug(err, "Cannot create "+name)
+	if strings.ToLower(filepath.Ext(name)) == ".json" {
+		enc := json.NewEncoder(f)
+		enc.SetIndent("", "\t")
+		debug(enc.Encode(entries), "Cannot write "+name)
+	} else {
+		w := csv.NewWriter(f)
+		debug(w.Write(manifestHeader), "Cannot write "+name)
+		for _, e := range entries {
+			debug(w.Write(e.record()), "Cannot write "+name)
+		}
+		w.Flush()
+		debug(w.Error(), "Cannot write "+name)
+	}
+	debug(f.Close(), "Cannot write "+name)
+	fmt.Println("Successfully generated " + name)
+}
@@ -0,0 +1,246 @@
+// Copyright (C) 2014-15 Michail Kargakis
+// This source code is subject to the terms
+// of the MIT License
+
+// Package bech32 implements the bech32 and bech32m encodings and
+// segregated witness addresses as specified in BIP173 and BIP350.
+package bech32
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+const charset = "qpzry9x8gf2tvdw0s3jn54khce6mua7l"
+
+// maxLength is the maximum length of a bech32 string.
+const maxLength = 90
+
+var (
+	// ErrMixedCase is returned for strings mixing upper and lower
+	// case characters.
+	ErrMixedCase = errors.New("bech32: mixed case")
+
+	// ErrChecksum is returned when a string fails checksum
+	// verification.
+	ErrChecksum = errors.New("bech32: invalid checksum")
+)
+
+// Variant selects the checksum constant of an encoding.
+type Variant uint32
+
+const (
+	// Bech32 is the original encoding of BIP173, used for witness
+	// version 0 addresses.
+	Bech32 Variant = 1
+	// Bech32m is the encoding of BIP350, used for witness version 1
+	// and later addresses.
+	Bech32m Variant = 0x2bc830a3
+)
+
+var generator = [5]uint32{0x3b6a57b2, 0x26508e6d, 0x1ea119fa, 0x3d4233dd, 0x2a1462b3}
+
+func polymod(values []byte) uint32 {
+	chk := uint32(1)
+	for _, v := range values {
+		top := chk >> 25
+		chk = (chk&0x1ffffff)<<5 ^ uint32(v)
+		for i := 0; i < 5; i++ {
+			if (top>>uint(i))&1 == 1 {
+				chk ^= generator[i]
+			}
+		}
+	}
+	return chk
+}
+
+func hrpExpand(hrp string) []byte {
+	out := make([]byte, 0, len(hrp)*2+1)
+	for i := 0; i < len(hrp); i++ {
+		out = append(out, hrp[i]>>5)
+	}
+	out = append(out, 0)
+	for i := 0; i < len(hrp); i++ {
+		out = append(out, hrp[i]&31)
+	}
+	return out
+}
+
+func createChecksum(hrp string, data []byte, variant Variant) []byte {
+	values := append(hrpExpand(hrp), data...)
+	values = append(values, 0, 0, 0, 0, 0, 0)
+	mod := polymod(values) ^ uint32(variant)
+	checksum := make([]byte, 6)
+	for i := range checksum {
+		checksum[i] = byte(mod>>uint(5*(5-i))) & 31
+	}
+	return checksum
+}
+
+// Encode encodes 5-bit data under the human-readable part hrp
+// using bech32.
+func Encode(hrp string, data []byte) (string, error) {
+	return EncodeVariant(hrp, data, Bech32)
+}
+
+// EncodeM encodes 5-bit data under the human-readable part hrp
+// using bech32m.
+func EncodeM(hrp string, data []byte) (string, error) {
+	return EncodeVariant(hrp, data, Bech32m)
+}
+
+// EncodeVariant encodes 5-bit data under the human-readable part
+// hrp using the given variant.
+func EncodeVariant(hrp string, data []byte, variant Variant) (string, error) {
+	if len(hrp)+len(data)+7 > maxLength {
+		return "", fmt.Errorf("bech32: string exceeds %d characters", maxLength)
+	}
+	if hrp == "" {
+		return "", errors.New("bech32: empty human-readable part")
+	}
+	for i := 0; i < len(hrp); i++ {
+		if hrp[i] < 33 || hrp[i] > 126 {
+			return "", fmt.Errorf("bech32: invalid human-readable part character %q", hrp[i])
+		}
+	}
+	if strings.ToLower(hrp) != hrp {
+		return "", ErrMixedCase
+	}
+	var b strings.Builder
+	b.WriteString(hrp)
+	b.WriteByte('1')
+	for _, v := range append(append([]byte{}, data...), createChecksum(hrp, data, variant)...) {
+		if v > 31 {
+			return "", fmt.Errorf("bech32: invalid data value %d", v)
+		}
+		b.WriteByte(charset[v])
+	}
+	return b.String(), nil
+}
+
+// Decode decodes a bech32 or bech32m string into its lowercase
+// human-readable part and 5-bit data, without the checksum, and
+// reports which variant it was encoded with.
+func Decode(s string) (string, []byte, Variant, error) {
+	if len(s) > maxLength {
+		return "", nil, 0, fmt.Errorf("bech32: string exceeds %d characters", maxLength)
+	}
+	lower, upper := strings.ToLower(s), strings.ToUpper(s)
+	if s != lower && s != upper {
+		return "", nil, 0, ErrMixedCase
+	}
+	s = lower
+	for i := 0; i < len(s); i++ {
+		if s[i] < 33 || s[i] > 126 {
+			return "", nil, 0, fmt.Errorf("bech32: invalid character %q", s[i])
+		}
+	}
+	pos := strings.LastIndexByte(s, '1')
+	if pos < 1 || pos+7 > len(s) {
+		return "", nil, 0, errors.New("bech32: invalid separator position")
+	}
+	hrp := s[:pos]
+	data := make([]byte, 0, len(s)-pos-1)
+	for i := pos + 1; i < len(s); i++ {
+		v := strings.IndexByte(charset, s[i])
+		if v < 0 {
+			return "", nil, 0, fmt.Errorf("bech32: invalid data character %q", s[i])
+		}
+		data = append(data, byte(v))
+	}
+	variant := Variant(polymod(append(hrpExpand(hrp), data...)))
+	if variant != Bech32 && variant != Bech32m {
+		return "", nil, 0, ErrChecksum
+	}
+	return hrp, data[:len(data)-6], variant, nil
+}
+
+// ConvertBits regroups data from groups of fromBits to groups of
+// toBits, padding the last group with zeroes when pad is set.
+func ConvertBits(data []byte, fromBits, toBits uint, pad bool) ([]byte, error) {
+	var acc uint32
+	var bits uint
+	maxv := uint32(1)<<toBits - 1
+	out := make([]byte, 0, len(data)*int(fromBits)/int(toBits)+1)
+	for _, v := range data {
+		if uint32(v)>>fromBits != 0 {
+			return nil, fmt.Errorf("bech32: invalid data value %d", v)
+		}
+		acc = acc<<fromBits | uint32(v)
+		bits += fromBits
+		for bits >= toBits {
+			bits -= toBits
+			out = append(out, byte(acc>>bits&maxv))
+		}
+	}
+	if pad {
+		if bits > 0 {
+			out = append(out, byte(acc<<(toBits-bits)&maxv))
+		}
+	} else if bits >= fromBits || acc<<(toBits-bits)&maxv != 0 {
+		return nil, errors.New("bech32: invalid padding")
+	}
+	return out, nil
+}
+
+// EncodeSegWitAddress encodes a witness program of the given version
+// as a segregated witness address. Version 0 programs are encoded
+// with bech32 and later versions with bech32m.
+func EncodeSegWitAddress(hrp string, version byte, program []byte) (string, error) {
+	if err := checkWitness(version, program); err != nil {
+		return "", err
+	}
+	data, err := ConvertBits(program, 8, 5, true)
+	if err != nil {
+		return "", err
+	}
+	return EncodeVariant(hrp, append([]byte{version}, data...), witnessVariant(version))
+}
+
+// DecodeSegWitAddress decodes a segregated witness address expected
+// to carry the human-readable part hrp into its witness version and
+// program.
+func DecodeSegWitAddress(hrp, addr string) (byte, []byte, error) {
+	gotHRP, data, variant, err := Decode(addr)
+	if err != nil {
+		return 0, nil, err
+	}
+	if gotHRP != hrp {
+		return 0, nil, fmt.Errorf("bech32: human-readable part %q, want %q", gotHRP, hrp)
+	}
+	if len(data) < 1 {
+		return 0, nil, errors.New("bech32: empty data")
+	}
+	if variant != witnessVariant(data[0]) {
+		return 0, nil, fmt.Errorf("bech32: wrong checksum variant for witness version %d", data[0])
+	}
+	program, err := ConvertBits(data[1:], 5, 8, false)
+	if err != nil {
+		return 0, nil, err
+	}
+	if err := checkWitness(data[0], program); err != nil {
+		return 0, nil, err
+	}
+	return data[0], program, nil
+}
+
+func witnessVariant(version byte) Variant {
+	if version == 0 {
+		return Bech32
+	}
+	return Bech32m
+}
+
+func checkWitness(version byte, program []byte) error {
+	if version > 16 {
+		return fmt.Errorf("bech32: invalid witness version %d", version)
+	}
+	if len(program) < 2 || len(program) > 40 {
+		return fmt.Errorf("bech32: invalid witness program length %d", len(program))
+	}
+	if version == 0 && len(program) != 20 && len(program) != 32 {
+		return fmt.Errorf("bech32: invalid witness program length %d for version 0", len(program))
+	}
+	return nil
+}
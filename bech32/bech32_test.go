@@ -0,0 +1,189 @@
+// Copyright (C) 2014-15 Michail Kargakis
+// This source code is subject to the terms
+// of the MIT License
+
+package bech32
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+)
+
+// valid are the valid strings of the BIP173 and BIP350 test vectors.
+var valid = []struct {
+	s       string
+	variant Variant
+}{
+	{"A12UEL5L", Bech32},
+	{"a12uel5l", Bech32},
+	{"an83characterlonghumanreadablepartthatcontainsthenumber1andtheexcludedcharactersbio1tt5tgs", Bech32},
+	{"abcdef1qpzry9x8gf2tvdw0s3jn54khce6mua7lmqqqxw", Bech32},
+	{"11qqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqc8247j", Bech32},
+	{"split1checkupstagehandshakeupstreamerranterredcaperred2y9e3w", Bech32},
+	{"?1ezyfcl", Bech32},
+	{"A1LQFN3A", Bech32m},
+	{"a1lqfn3a", Bech32m},
+	{"an83characterlonghumanreadablepartthatcontainsthetheexcludedcharactersbioandnumber11sg7hg6", Bech32m},
+	{"abcdef1l7aum6echk45nj3s0wdvt2fg8x9yrzpqzd3ryx", Bech32m},
+	{"11llllllllllllllllllllllllllllllllllllllllllllllllllllllllllllllllllllllllllllllllllludsr8", Bech32m},
+	{"split1checkupstagehandshakeupstreamerranterredcaperredlc445v", Bech32m},
+	{"?1v759aa", Bech32m},
+}
+
+// invalid are the invalid strings of the BIP173 and BIP350 test
+// vectors.
+var invalid = []string{
+	// HRP character out of range.
+	"\x201nwldj5",
+	"\x7f1axkwrx",
+	"\x801eym55h",
+	"\x201xj0phk",
+	"\x7f1g6xzxy",
+	"\x801vctc34",
+	// Overall max length exceeded.
+	"an84characterslonghumanreadablepartthatcontainsthenumber1andtheexcludedcharactersbio1569pvx",
+	"an84characterslonghumanreadablepartthatcontainsthetheexcludedcharactersbioandnumber11d6pts4",
+	// No separator character.
+	"pzry9x0s0muk",
+	"qyrz8wqd2c9m",
+	// Empty HRP.
+	"1pzry9x0s0muk",
+	"1qyrz8wqd2c9m",
+	"10a06t8",
+	"1qzzfhee",
+	"16plkw9",
+	"1p2gdwpf",
+	// Invalid data character.
+	"x1b4n0q5v",
+	"y1b0jsk6g",
+	"lt1igcx5c0",
+	// Too short checksum.
+	"li1dgmt3",
+	"in1muywd",
+	// Invalid character in checksum.
+	"de1lg7wt\xff",
+	"mm1crxm3i",
+	"au1s5cgom",
+	// Checksum calculated with uppercase form of HRP.
+	"A1G7SGD8",
+	"M1VUXWEZ",
+}
+
+func TestDecode(t *testing.T) {
+	for _, v := range valid {
+		hrp, data, variant, err := Decode(v.s)
+		if err != nil {
+			t.Errorf("%s: %v", v.s, err)
+			continue
+		}
+		if variant != v.variant {
+			t.Errorf("%s: variant %x, want %x", v.s, variant, v.variant)
+		}
+		s, err := EncodeVariant(hrp, data, variant)
+		if err != nil {
+			t.Fatalf("%s: %v", v.s, err)
+		}
+		if s != strings.ToLower(v.s) {
+			t.Errorf("%s: encoded %s", v.s, s)
+		}
+	}
+	for _, s := range invalid {
+		if _, _, _, err := Decode(s); err == nil {
+			t.Errorf("%q: decoded", s)
+		}
+	}
+}
+
+// addresses are the valid segwit addresses of the BIP173 and BIP350
+// test vectors along with their output scripts.
+var addresses = []struct {
+	address, script string
+}{
+	{"BC1QW508D6QEJXTDG4Y5R3ZARVARY0C5XW7KV8F3T4", "0014751e76e8199196d454941c45d1b3a323f1433bd6"},
+	{"tb1qrp33g0q5c5txsp9arysrx4k6zdkfs4nce4xj0gdcccefvpysxf3q0sl5k7", "00201863143c14c5166804bd19203356da136c985678cd4d27a1b8c6329604903262"},
+	{"bc1pw508d6qejxtdg4y5r3zarvary0c5xw7kw508d6qejxtdg4y5r3zarvary0c5xw7kt5nd6y", "5128751e76e8199196d454941c45d1b3a323f1433bd6751e76e8199196d454941c45d1b3a323f1433bd6"},
+	{"BC1SW50QGDZ25J", "6002751e"},
+	{"bc1zw508d6qejxtdg4y5r3zarvaryvaxxpcs", "5210751e76e8199196d454941c45d1b3a323"},
+	{"tb1qqqqqp399et2xygdj5xreqhjjvcmzhxw4aywxecjdzew6hylgvsesrxh6hy", "0020000000c4a5cad46221b2a187905e5266362b99d5e91c6ce24d165dab93e86433"},
+	{"tb1pqqqqp399et2xygdj5xreqhjjvcmzhxw4aywxecjdzew6hylgvsesf3hn0c", "5120000000c4a5cad46221b2a187905e5266362b99d5e91c6ce24d165dab93e86433"},
+	{"bc1p0xlxvlhemja6c4dqv22uapctqupfhlxm9h8z3k2e72q4k9hcz7vqzk5jj0", "512079be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798"},
+}
+
+// invalidAddresses are the invalid segwit addresses of the BIP173 and
+// BIP350 test vectors.
+var invalidAddresses = []string{
+	// Invalid human-readable part.
+	"tc1qw508d6qejxtdg4y5r3zarvary0c5xw7kg3g4ty",
+	"tc1p0xlxvlhemja6c4dqv22uapctqupfhlxm9h8z3k2e72q4k9hcz7vq5zuyut",
+	// Invalid checksum.
+	"bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t5",
+	// Invalid witness version.
+	"BC13W508D6QEJXTDG4Y5R3ZARVARY0C5XW7KN40WF2",
+	// Invalid program length.
+	"bc1rw5uspcuh",
+	"bc10w508d6qejxtdg4y5r3zarvary0c5xw7kw508d6qejxtdg4y5r3zarvary0c5xw7kw5rljs90",
+	// Invalid program length for witness version 0.
+	"BC1QR508D6QEJXTDG4Y5R3ZARVARYV98GJ9P",
+	// Mixed case.
+	"tb1qrp33g0q5c5txsp9arysrx4k6zdkfs4nce4xj0gdcccefvpysxf3q0sL5k7",
+	"tb1p0xlxvlhemja6c4dqv22uapctqupfhlxm9h8z3k2e72q4k9hcz7vq47Zagq",
+	// Zero padding of more than 4 bits.
+	"bc1zw508d6qejxtdg4y5r3zarvaryvqyzf3du",
+	"bc1p0xlxvlhemja6c4dqv22uapctqupfhlxm9h8z3k2e72q4k9hcz7v07qwwzcrf",
+	// Non-zero padding in 8-to-5 conversion.
+	"tb1qrp33g0q5c5txsp9arysrx4k6zdkfs4nce4xj0gdcccefvpysxf3pjxtptv",
+	"tb1p0xlxvlhemja6c4dqv22uapctqupfhlxm9h8z3k2e72q4k9hcz7vpggkg4j",
+	// Empty data section.
+	"bc1gmk9yu",
+	// Bech32 instead of bech32m and vice versa.
+	"bc1p0xlxvlhemja6c4dqv22uapctqupfhlxm9h8z3k2e72q4k9hcz7vqh2y7hd",
+	"tb1z0xlxvlhemja6c4dqv22uapctqupfhlxm9h8z3k2e72q4k9hcz7vqglt7rf",
+	"BC1S0XLXVLHEMJA6C4DQV22UAPCTQUPFHLXM9H8Z3K2E72Q4K9HCZ7VQ54WELL",
+	"bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kemeawh",
+	"tb1q0xlxvlhemja6c4dqv22uapctqupfhlxm9h8z3k2e72q4k9hcz7vq24jc47",
+	// Invalid character in checksum.
+	"bc1p38j9r5y49hruaue7wxjce0updqjuyyx0kh56v8s25huc6995vvpql3jow4",
+	// Invalid program length for witness version 1.
+	"bc1pw5dgrnzv",
+	"bc1p0xlxvlhemja6c4dqv22uapctqupfhlxm9h8z3k2e72q4k9hcz7v8n0nx0muaewav253zgeav",
+}
+
+func TestSegWitAddress(t *testing.T) {
+	for _, a := range addresses {
+		hrp := strings.ToLower(a.address[:2])
+		version, program, err := DecodeSegWitAddress(hrp, a.address)
+		if err != nil {
+			t.Errorf("%s: %v", a.address, err)
+			continue
+		}
+		if got := hex.EncodeToString(witnessScript(version, program)); got != a.script {
+			t.Errorf("%s: script %s, want %s", a.address, got, a.script)
+		}
+		address, err := EncodeSegWitAddress(hrp, version, program)
+		if err != nil {
+			t.Fatalf("%s: %v", a.address, err)
+		}
+		if address != strings.ToLower(a.address) {
+			t.Errorf("%s: encoded %s", a.address, address)
+		}
+	}
+	for _, a := range invalidAddresses {
+		hrp := "bc"
+		if strings.HasPrefix(strings.ToLower(a), "tb") {
+			hrp = "tb"
+		}
+		if _, _, err := DecodeSegWitAddress(hrp, a); err == nil {
+			t.Errorf("%s: decoded", a)
+		}
+	}
+}
+
+// witnessScript returns the output script paying to a witness program.
+func witnessScript(version byte, program []byte) []byte {
+	op := version
+	if version > 0 {
+		op += 0x50
+	}
+	return append([]byte{op, byte(len(program))}, program...)
+}
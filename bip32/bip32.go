@@ -0,0 +1,319 @@
+// Copyright (C) 2014-15 Michail Kargakis
+// This source code is subject to the terms
+// of the MIT License
+
+// Package bip32 implements hierarchical deterministic extended
+// keys as specified in BIP32.
+package bip32
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcutil"
+	"github.com/btcsuite/btcutil/base58"
+)
+
+// HardenedKeyStart is the index of the first hardened child key.
+const HardenedKeyStart = 0x80000000
+
+const serializedKeyLen = 4 + 1 + 4 + 4 + 32 + 33
+
+var (
+	// ErrInvalidSeed is returned when a seed is out of the allowed
+	// length or yields an invalid master key.
+	ErrInvalidSeed = errors.New("bip32: invalid seed")
+
+	// ErrInvalidChild is returned for the rare child index that
+	// yields an invalid key. Callers should move to the next index.
+	ErrInvalidChild = errors.New("bip32: invalid child key")
+
+	// ErrHardenedFromPublic is returned when a hardened child is
+	// requested from an extended public key.
+	ErrHardenedFromPublic = errors.New("bip32: cannot derive a hardened key from a public key")
+
+	// ErrInvalidKey is returned for malformed serialized keys.
+	ErrInvalidKey = errors.New("bip32: invalid extended key")
+
+	masterHMACKey = []byte("Bitcoin seed")
+)
+
+// Versions holds the version bytes of serialized extended private
+// and public keys of a network, such as xprv/xpub or tprv/tpub.
+type Versions struct {
+	Private [4]byte
+	Public  [4]byte
+}
+
+// ExtendedKey is a private or public key along with the chain code
+// and position needed to derive its children.
+type ExtendedKey struct {
+	versions  Versions
+	depth     uint8
+	parentFP  [4]byte
+	childNum  uint32
+	chainCode []byte
+	// key is a 32-byte private key or a 33-byte compressed
+	// public key.
+	key       []byte
+	isPrivate bool
+}
+
+// NewMaster returns the master extended private key of seed.
+func NewMaster(seed []byte, versions Versions) (*ExtendedKey, error) {
+	if len(seed) < 16 || len(seed) > 64 {
+		return nil, ErrInvalidSeed
+	}
+	mac := hmac.New(sha512.New, masterHMACKey)
+	mac.Write(seed)
+	i := mac.Sum(nil)
+	if !validPrivKey(i[:32]) {
+		return nil, ErrInvalidSeed
+	}
+	return &ExtendedKey{
+		versions:  versions,
+		chainCode: i[32:],
+		key:       i[:32],
+		isPrivate: true,
+	}, nil
+}
+
+// IsPrivate reports whether k is an extended private key.
+func (k *ExtendedKey) IsPrivate() bool { return k.isPrivate }
+
+// Depth returns the number of derivations from the master key.
+func (k *ExtendedKey) Depth() uint8 { return k.depth }
+
+// ChildNum returns the index k was derived at.
+func (k *ExtendedKey) ChildNum() uint32 { return k.childNum }
+
+// Child derives the child key at index i. Indexes from
+// HardenedKeyStart onwards derive hardened children.
+func (k *ExtendedKey) Child(i uint32) (*ExtendedKey, error) {
+	hardened := i >= HardenedKeyStart
+	if hardened && !k.isPrivate {
+		return nil, ErrHardenedFromPublic
+	}
+
+	var data []byte
+	if hardened {
+		data = append([]byte{0x00}, k.key...)
+	} else {
+		data = k.pubKeyBytes()
+	}
+	var index [4]byte
+	binary.BigEndian.PutUint32(index[:], i)
+	data = append(data, index[:]...)
+
+	mac := hmac.New(sha512.New, k.chainCode)
+	mac.Write(data)
+	sum := mac.Sum(nil)
+	il, chainCode := sum[:32], sum[32:]
+	if new(big.Int).SetBytes(il).Cmp(btcec.S256().N) >= 0 {
+		return nil, ErrInvalidChild
+	}
+
+	var childKey []byte
+	if k.isPrivate {
+		n := new(big.Int).SetBytes(il)
+		n.Add(n, new(big.Int).SetBytes(k.key))
+		n.Mod(n, btcec.S256().N)
+		if n.Sign() == 0 {
+			return nil, ErrInvalidChild
+		}
+		childKey = paddedBytes(n, 32)
+	} else {
+		curve := btcec.S256()
+		parent, err := btcec.ParsePubKey(k.key, curve)
+		if err != nil {
+			return nil, err
+		}
+		x, y := curve.ScalarBaseMult(il)
+		x, y = curve.Add(x, y, parent.X, parent.Y)
+		if x.Sign() == 0 && y.Sign() == 0 {
+			return nil, ErrInvalidChild
+		}
+		childKey = (&btcec.PublicKey{Curve: curve, X: x, Y: y}).SerializeCompressed()
+	}
+
+	var fp [4]byte
+	copy(fp[:], btcutil.Hash160(k.pubKeyBytes()))
+	return &ExtendedKey{
+		versions:  k.versions,
+		depth:     k.depth + 1,
+		parentFP:  fp,
+		childNum:  i,
+		chainCode: chainCode,
+		key:       childKey,
+		isPrivate: k.isPrivate,
+	}, nil
+}
+
+// Derive walks path, such as m/44'/0'/0'/0/0, from k.
+func (k *ExtendedKey) Derive(path string) (*ExtendedKey, error) {
+	indexes, err := ParsePath(path)
+	if err != nil {
+		return nil, err
+	}
+	key := k
+	for _, i := range indexes {
+		if key, err = key.Child(i); err != nil {
+			return nil, err
+		}
+	}
+	return key, nil
+}
+
+// Neuter returns the extended public key of k.
+func (k *ExtendedKey) Neuter() *ExtendedKey {
+	if !k.isPrivate {
+		return k
+	}
+	return &ExtendedKey{
+		versions:  k.versions,
+		depth:     k.depth,
+		parentFP:  k.parentFP,
+		childNum:  k.childNum,
+		chainCode: k.chainCode,
+		key:       k.pubKeyBytes(),
+	}
+}
+
+// ECPrivKey returns the secp256k1 private key of k.
+func (k *ExtendedKey) ECPrivKey() (*btcec.PrivateKey, error) {
+	if !k.isPrivate {
+		return nil, errors.New("bip32: not a private key")
+	}
+	pk, _ := btcec.PrivKeyFromBytes(btcec.S256(), k.key)
+	return pk, nil
+}
+
+// ECPubKey returns the secp256k1 public key of k.
+func (k *ExtendedKey) ECPubKey() (*btcec.PublicKey, error) {
+	return btcec.ParsePubKey(k.pubKeyBytes(), btcec.S256())
+}
+
+// String returns k serialized in base58 with a checksum.
+func (k *ExtendedKey) String() string {
+	buf := make([]byte, 0, serializedKeyLen+4)
+	if k.isPrivate {
+		buf = append(buf, k.versions.Private[:]...)
+	} else {
+		buf = append(buf, k.versions.Public[:]...)
+	}
+	buf = append(buf, k.depth)
+	buf = append(buf, k.parentFP[:]...)
+	var index [4]byte
+	binary.BigEndian.PutUint32(index[:], k.childNum)
+	buf = append(buf, index[:]...)
+	buf = append(buf, k.chainCode...)
+	if k.isPrivate {
+		buf = append(buf, 0x00)
+	}
+	buf = append(buf, k.key...)
+	return base58.Encode(append(buf, checksum(buf)...))
+}
+
+// Parse decodes a serialized extended key whose version bytes
+// belong to versions.
+func Parse(s string, versions Versions) (*ExtendedKey, error) {
+	buf := base58.Decode(s)
+	if len(buf) != serializedKeyLen+4 {
+		return nil, ErrInvalidKey
+	}
+	payload := buf[:serializedKeyLen]
+	if !bytes.Equal(checksum(payload), buf[serializedKeyLen:]) {
+		return nil, ErrInvalidKey
+	}
+
+	k := &ExtendedKey{versions: versions}
+	switch {
+	case bytes.Equal(payload[:4], versions.Private[:]):
+		k.isPrivate = true
+	case bytes.Equal(payload[:4], versions.Public[:]):
+	default:
+		return nil, fmt.Errorf("bip32: unknown version %x", payload[:4])
+	}
+	k.depth = payload[4]
+	copy(k.parentFP[:], payload[5:9])
+	k.childNum = binary.BigEndian.Uint32(payload[9:13])
+	k.chainCode = append([]byte{}, payload[13:45]...)
+
+	key := payload[45:]
+	if k.isPrivate {
+		if key[0] != 0x00 || !validPrivKey(key[1:]) {
+			return nil, ErrInvalidKey
+		}
+		k.key = append([]byte{}, key[1:]...)
+	} else {
+		if _, err := btcec.ParsePubKey(key, btcec.S256()); err != nil {
+			return nil, ErrInvalidKey
+		}
+		k.key = append([]byte{}, key...)
+	}
+	if k.depth == 0 && (k.childNum != 0 || k.parentFP != [4]byte{}) {
+		return nil, ErrInvalidKey
+	}
+	return k, nil
+}
+
+// ParsePath parses a derivation path such as m/44'/0'/0'/0/0 into
+// child indexes. Hardened indexes are marked with ' or h.
+func ParsePath(path string) ([]uint32, error) {
+	parts := strings.Split(strings.TrimSpace(path), "/")
+	if parts[0] != "m" && parts[0] != "M" {
+		return nil, fmt.Errorf("bip32: path %q must start with m", path)
+	}
+	indexes := make([]uint32, 0, len(parts)-1)
+	for _, part := range parts[1:] {
+		hardened := strings.HasSuffix(part, "'") || strings.HasSuffix(part, "h") || strings.HasSuffix(part, "H")
+		if hardened {
+			part = part[:len(part)-1]
+		}
+		i, err := strconv.ParseUint(part, 10, 32)
+		if err != nil || i >= HardenedKeyStart {
+			return nil, fmt.Errorf("bip32: invalid path element %q in %q", part, path)
+		}
+		if hardened {
+			i += HardenedKeyStart
+		}
+		indexes = append(indexes, uint32(i))
+	}
+	return indexes, nil
+}
+
+func (k *ExtendedKey) pubKeyBytes() []byte {
+	if !k.isPrivate {
+		return k.key
+	}
+	_, pub := btcec.PrivKeyFromBytes(btcec.S256(), k.key)
+	return pub.SerializeCompressed()
+}
+
+func validPrivKey(b []byte) bool {
+	n := new(big.Int).SetBytes(b)
+	return n.Sign() != 0 && n.Cmp(btcec.S256().N) < 0
+}
+
+func paddedBytes(n *big.Int, size int) []byte {
+	b := n.Bytes()
+	if len(b) >= size {
+		return b
+	}
+	return append(make([]byte, size-len(b)), b...)
+}
+
+func checksum(b []byte) []byte {
+	first := sha256.Sum256(b)
+	second := sha256.Sum256(first[:])
+	return second[:4]
+}
@@ -0,0 +1,130 @@
+// Copyright (C) 2014-15 Michail Kargakis
+// This source code is subject to the terms
+// of the MIT License
+
+package bip32
+
+import (
+	"encoding/hex"
+	"testing"
+)
+
+var mainnet = Versions{
+	Private: [4]byte{0x04, 0x88, 0xad, 0xe4},
+	Public:  [4]byte{0x04, 0x88, 0xb2, 0x1e},
+}
+
+// vectors are test vectors 1 to 3 of BIP32.
+var vectors = []struct {
+	seed string
+	keys []struct{ path, xpub, xprv string }
+}{
+	{
+		seed: "000102030405060708090a0b0c0d0e0f",
+		keys: []struct{ path, xpub, xprv string }{
+			{"m", "xpub661MyMwAqRbcFtXgS5sYJABqqG9YLmC4Q1Rdap9gSE8NqtwybGhePY2gZ29ESFjqJoCu1Rupje8YtGqsefD265TMg7usUDFdp6W1EGMcet8", "xprv9s21ZrQH143K3QTDL4LXw2F7HEK3wJUD2nW2nRk4stbPy6cq3jPPqjiChkVvvNKmPGJxWUtg6LnF5kejMRNNU3TGtRBeJgk33yuGBxrMPHi"},
+			{"m/0'", "xpub68Gmy5EdvgibQVfPdqkBBCHxA5htiqg55crXYuXoQRKfDBFA1WEjWgP6LHhwBZeNK1VTsfTFUHCdrfp1bgwQ9xv5ski8PX9rL2dZXvgGDnw", "xprv9uHRZZhk6KAJC1avXpDAp4MDc3sQKNxDiPvvkX8Br5ngLNv1TxvUxt4cV1rGL5hj6KCesnDYUhd7oWgT11eZG7XnxHrnYeSvkzY7d2bhkJ7"},
+			{"m/0'/1", "xpub6ASuArnXKPbfEwhqN6e3mwBcDTgzisQN1wXN9BJcM47sSikHjJf3UFHKkNAWbWMiGj7Wf5uMash7SyYq527Hqck2AxYysAA7xmALppuCkwQ", "xprv9wTYmMFdV23N2TdNG573QoEsfRrWKQgWeibmLntzniatZvR9BmLnvSxqu53Kw1UmYPxLgboyZQaXwTCg8MSY3H2EU4pWcQDnRnrVA1xe8fs"},
+			{"m/0'/1/2'", "xpub6D4BDPcP2GT577Vvch3R8wDkScZWzQzMMUm3PWbmWvVJrZwQY4VUNgqFJPMM3No2dFDFGTsxxpG5uJh7n7epu4trkrX7x7DogT5Uv6fcLW5", "xprv9z4pot5VBttmtdRTWfWQmoH1taj2axGVzFqSb8C9xaxKymcFzXBDptWmT7FwuEzG3ryjH4ktypQSAewRiNMjANTtpgP4mLTj34bhnZX7UiM"},
+			{"m/0'/1/2'/2", "xpub6FHa3pjLCk84BayeJxFW2SP4XRrFd1JYnxeLeU8EqN3vDfZmbqBqaGJAyiLjTAwm6ZLRQUMv1ZACTj37sR62cfN7fe5JnJ7dh8zL4fiyLHV", "xprvA2JDeKCSNNZky6uBCviVfJSKyQ1mDYahRjijr5idH2WwLsEd4Hsb2Tyh8RfQMuPh7f7RtyzTtdrbdqqsunu5Mm3wDvUAKRHSC34sJ7in334"},
+			{"m/0'/1/2'/2/1000000000", "xpub6H1LXWLaKsWFhvm6RVpEL9P4KfRZSW7abD2ttkWP3SSQvnyA8FSVqNTEcYFgJS2UaFcxupHiYkro49S8yGasTvXEYBVPamhGW6cFJodrTHy", "xprvA41z7zogVVwxVSgdKUHDy1SKmdb533PjDz7J6N6mV6uS3ze1ai8FHa8kmHScGpWmj4WggLyQjgPie1rFSruoUihUZREPSL39UNdE3BBDu76"},
+		},
+	},
+	{
+		seed: "fffcf9f6f3f0edeae7e4e1dedbd8d5d2cfccc9c6c3c0bdbab7b4b1aeaba8a5a29f9c999693908d8a8784817e7b7875726f6c696663605d5a5754514e4b484542",
+		keys: []struct{ path, xpub, xprv string }{
+			{"m", "xpub661MyMwAqRbcFW31YEwpkMuc5THy2PSt5bDMsktWQcFF8syAmRUapSCGu8ED9W6oDMSgv6Zz8idoc4a6mr8BDzTJY47LJhkJ8UB7WEGuduB", "xprv9s21ZrQH143K31xYSDQpPDxsXRTUcvj2iNHm5NUtrGiGG5e2DtALGdso3pGz6ssrdK4PFmM8NSpSBHNqPqm55Qn3LqFtT2emdEXVYsCzC2U"},
+			{"m/0", "xpub69H7F5d8KSRgmmdJg2KhpAK8SR3DjMwAdkxj3ZuxV27CprR9LgpeyGmXUbC6wb7ERfvrnKZjXoUmmDznezpbZb7ap6r1D3tgFxHmwMkQTPH", "xprv9vHkqa6EV4sPZHYqZznhT2NPtPCjKuDKGY38FBWLvgaDx45zo9WQRUT3dKYnjwih2yJD9mkrocEZXo1ex8G81dwSM1fwqWpWkeS3v86pgKt"},
+			{"m/0/2147483647'", "xpub6ASAVgeehLbnwdqV6UKMHVzgqAG8Gr6riv3Fxxpj8ksbH9ebxaEyBLZ85ySDhKiLDBrQSARLq1uNRts8RuJiHjaDMBU4Zn9h8LZNnBC5y4a", "xprv9wSp6B7kry3Vj9m1zSnLvN3xH8RdsPP1Mh7fAaR7aRLcQMKTR2vidYEeEg2mUCTAwCd6vnxVrcjfy2kRgVsFawNzmjuHc2YmYRmagcEPdU9"},
+			{"m/0/2147483647'/1", "xpub6DF8uhdarytz3FWdA8TvFSvvAh8dP3283MY7p2V4SeE2wyWmG5mg5EwVvmdMVCQcoNJxGoWaU9DCWh89LojfZ537wTfunKau47EL2dhHKon", "xprv9zFnWC6h2cLgpmSA46vutJzBcfJ8yaJGg8cX1e5StJh45BBciYTRXSd25UEPVuesF9yog62tGAQtHjXajPPdbRCHuWS6T8XA2ECKADdw4Ef"},
+			{"m/0/2147483647'/1/2147483646'", "xpub6ERApfZwUNrhLCkDtcHTcxd75RbzS1ed54G1LkBUHQVHQKqhMkhgbmJbZRkrgZw4koxb5JaHWkY4ALHY2grBGRjaDMzQLcgJvLJuZZvRcEL", "xprvA1RpRA33e1JQ7ifknakTFpgNXPmW2YvmhqLQYMmrj4xJXXWYpDPS3xz7iAxn8L39njGVyuoseXzU6rcxFLJ8HFsTjSyQbLYnMpCqE2VbFWc"},
+			{"m/0/2147483647'/1/2147483646'/2", "xpub6FnCn6nSzZAw5Tw7cgR9bi15UV96gLZhjDstkXXxvCLsUXBGXPdSnLFbdpq8p9HmGsApME5hQTZ3emM2rnY5agb9rXpVGyy3bdW6EEgAtqt", "xprvA2nrNbFZABcdryreWet9Ea4LvTJcGsqrMzxHx98MMrotbir7yrKCEXw7nadnHM8Dq38EGfSh6dqA9QWTyefMLEcBYJUuekgW4BYPJcr9E7j"},
+		},
+	},
+	{
+		// Retention of leading zeros.
+		seed: "4b381541583be4423346c643850da4b320e46a87ae3d2a4e6da11eba819cd4acba45d239319ac14f863b8d5ab5a0d0c64d2e8a1e7d1457df2e5a3c51c73235be",
+		keys: []struct{ path, xpub, xprv string }{
+			{"m", "xpub661MyMwAqRbcEZVB4dScxMAdx6d4nFc9nvyvH3v4gJL378CSRZiYmhRoP7mBy6gSPSCYk6SzXPTf3ND1cZAceL7SfJ1Z3GC8vBgp2epUt13", "xprv9s21ZrQH143K25QhxbucbDDuQ4naNntJRi4KUfWT7xo4EKsHt2QJDu7KXp1A3u7Bi1j8ph3EGsZ9Xvz9dGuVrtHHs7pXeTzjuxBrCmmhgC6"},
+			{"m/0'", "xpub68NZiKmJWnxxS6aaHmn81bvJeTESw724CRDs6HbuccFQN9Ku14VQrADWgqbhhTHBaohPX4CjNLf9fq9MYo6oDaPPLPxSb7gwQN3ih19Zm4Y", "xprv9uPDJpEQgRQfDcW7BkF7eTya6RPxXeJCqCJGHuCJ4GiRVLzkTXBAJMu2qaMWPrS7AANYqdq6vcBcBUdJCVVFceUvJFjaPdGZ2y9WACViL4L"},
+		},
+	},
+}
+
+func TestVectors(t *testing.T) {
+	for _, v := range vectors {
+		seed, _ := hex.DecodeString(v.seed)
+		master, err := NewMaster(seed, mainnet)
+		if err != nil {
+			t.Fatalf("NewMaster(%s): %v", v.seed, err)
+		}
+		for _, want := range v.keys {
+			key, err := master.Derive(want.path)
+			if err != nil {
+				t.Fatalf("Derive(%s): %v", want.path, err)
+			}
+			if xprv := key.String(); xprv != want.xprv {
+				t.Errorf("%s: xprv %s, want %s", want.path, xprv, want.xprv)
+			}
+			if xpub := key.Neuter().String(); xpub != want.xpub {
+				t.Errorf("%s: xpub %s, want %s", want.path, xpub, want.xpub)
+			}
+		}
+	}
+}
+
+func TestParse(t *testing.T) {
+	for _, v := range vectors {
+		for _, want := range v.keys {
+			for _, s := range []string{want.xprv, want.xpub} {
+				key, err := Parse(s, mainnet)
+				if err != nil {
+					t.Errorf("Parse(%s): %v", s, err)
+					continue
+				}
+				if key.String() != s {
+					t.Errorf("Parse(%s) round-trips to %s", s, key.String())
+				}
+				if key.IsPrivate() != (s == want.xprv) {
+					t.Errorf("Parse(%s).IsPrivate() = %v", s, key.IsPrivate())
+				}
+			}
+		}
+	}
+
+	xpub := vectors[0].keys[0].xpub
+	corrupted := xpub[:len(xpub)-1] + "9"
+	if _, err := Parse(corrupted, mainnet); err != ErrInvalidKey {
+		t.Errorf("Parse of a bad checksum: %v, want %v", err, ErrInvalidKey)
+	}
+	testnet := Versions{Private: [4]byte{0x04, 0x35, 0x83, 0x94}, Public: [4]byte{0x04, 0x35, 0x87, 0xcf}}
+	if _, err := Parse(xpub, testnet); err == nil {
+		t.Error("Parse accepted the version bytes of another network")
+	}
+}
+
+func TestPublicDerivation(t *testing.T) {
+	seed, _ := hex.DecodeString(vectors[0].seed)
+	master, err := NewMaster(seed, mainnet)
+	if err != nil {
+		t.Fatal(err)
+	}
+	account, err := master.Derive("m/0'")
+	if err != nil {
+		t.Fatal(err)
+	}
+	child, err := account.Neuter().Child(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if child.String() != vectors[0].keys[2].xpub {
+		t.Errorf("public child %s, want %s", child, vectors[0].keys[2].xpub)
+	}
+
+	if _, err := account.Neuter().Child(HardenedKeyStart); err != ErrHardenedFromPublic {
+		t.Errorf("hardened child of a public key: %v, want %v", err, ErrHardenedFromPublic)
+	}
+	if _, err := master.Neuter().Derive("m/0'"); err != ErrHardenedFromPublic {
+		t.Errorf("hardened path of a public key: %v, want %v", err, ErrHardenedFromPublic)
+	}
+}
@@ -0,0 +1,55 @@
+// Copyright (C) 2014-15 Michail Kargakis
+// This source code is subject to the terms
+// of the MIT License
+
+package main
+
+import (
+	"fmt"
+
+	"github.com/btcsuite/btcutil"
+	"github.com/kargakis/cryptowallet/bip38"
+	"github.com/kargakis/cryptowallet/wallet"
+)
+
+// decryptPrivKey decrypts a BIP38 encrypted private key and prints
+// it in WIF along with its address.
+func decryptPrivKey(encrypted string) {
+	passphrase := readPassphrase("Passphrase: ", false)
+	pk, compressed, err := bip38.Decrypt(encrypted, passphrase, network.PubKeyHashID)
+	debug(err, "Cannot decrypt private key")
+	wif, err := btcutil.NewWIF(pk, network.Params, compressed)
+	debug(err, "Cannot encode private key to WIF")
+	addr, err := wallet.NewAddress(wif, network, wallet.AddressType(conf.AddressType))
+	debug(err, "Cannot extract public address from private key")
+	fmt.Println(wif)
+	fmt.Println(addr)
+}
+
+// newIntermediateCode prints a BIP38 passphrase intermediate code for
+// a passphrase read from the terminal. Whoever holds the code can
+// generate encrypted keys that only the passphrase can decrypt.
+func newIntermediateCode() {
+	passphrase := readPassphrase("BIP38 passphrase: ", true)
+	var code string
+	var err error
+	if conf.Lot >= 0 {
+		code, err = bip38.IntermediateCodeLotSequence(passphrase, uint32(conf.Lot), uint32(conf.Sequence))
+	} else {
+		code, err = bip38.IntermediateCode(passphrase)
+	}
+	debug(err, "Cannot create intermediate code")
+	fmt.Println(code)
+}
+
+// verifyConfirmation checks a BIP38 confirmation code against a
+// passphrase read from the terminal and prints the address of the
+// encrypted key it confirms.
+func verifyConfirmation(confirmation string) {
+	passphrase := readPassphrase("BIP38 passphrase: ", false)
+	pub, compressed, err := bip38.VerifyConfirmation(confirmation, passphrase, network.PubKeyHashID)
+	debug(err, "Cannot verify confirmation code")
+	addr, err := wallet.NewPubKeyAddress(pub, compressed, network, wallet.AddressType(conf.AddressType))
+	debug(err, "Cannot extract public address from public key")
+	fmt.Println(addr)
+}
@@ -0,0 +1,147 @@
+// Copyright (C) 2014-15 Michail Kargakis
+// This source code is subject to the terms
+// of the MIT License
+
+// Package bip38 implements passphrase-protected private keys as
+// specified in BIP38.
+package bip38
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/sha256"
+	"errors"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcutil"
+	"github.com/btcsuite/btcutil/base58"
+	"golang.org/x/crypto/scrypt"
+	"golang.org/x/text/unicode/norm"
+)
+
+// Prefix bytes of encrypted keys.
+var nonECMultiplyPrefix = []byte{0x01, 0x42}
+
+// Flag bits of encrypted keys.
+const (
+	flagNonECMultiply = 0xc0
+	flagCompressed    = 0x20
+)
+
+const encryptedKeyLen = 39
+
+var (
+	// ErrInvalidKey is returned for strings that are not BIP38
+	// encrypted keys.
+	ErrInvalidKey = errors.New("bip38: invalid encrypted key")
+
+	// ErrPassphrase is returned when the passphrase does not match
+	// the encrypted key.
+	ErrPassphrase = errors.New("bip38: wrong passphrase")
+)
+
+// Encrypt encrypts pk with passphrase without EC multiplication.
+// The address hash binds the result to the pay-to-pubkey-hash
+// address of pk on the network identified by pubKeyHashID.
+func Encrypt(pk *btcec.PrivateKey, compressed bool, passphrase string, pubKeyHashID []byte) (string, error) {
+	salt := addressHash(pk.PubKey(), compressed, pubKeyHashID)
+	derived, err := scrypt.Key(norm.NFC.Bytes([]byte(passphrase)), salt, 16384, 8, 8, 64)
+	if err != nil {
+		return "", err
+	}
+	block, err := aes.NewCipher(derived[32:])
+	if err != nil {
+		return "", err
+	}
+
+	key := paddedKey(pk)
+	encrypted := make([]byte, 32)
+	for i := 0; i < 32; i++ {
+		key[i] ^= derived[i]
+	}
+	block.Encrypt(encrypted[:16], key[:16])
+	block.Encrypt(encrypted[16:], key[16:])
+
+	flag := byte(flagNonECMultiply)
+	if compressed {
+		flag |= flagCompressed
+	}
+	payload := append(append([]byte{}, nonECMultiplyPrefix...), flag)
+	payload = append(payload, salt...)
+	payload = append(payload, encrypted...)
+	return encode(payload), nil
+}
+
+// Decrypt decrypts a key produced by Encrypt or GenerateEncryptedKey
+// and reports whether its public key is compressed.
+func Decrypt(encrypted, passphrase string, pubKeyHashID []byte) (*btcec.PrivateKey, bool, error) {
+	payload, err := decode(encrypted)
+	if err != nil {
+		return nil, false, err
+	}
+	if bytes.Equal(payload[:2], ecMultiplyPrefix) {
+		return decryptECMultiply(payload, passphrase, pubKeyHashID)
+	}
+	if !bytes.Equal(payload[:2], nonECMultiplyPrefix) || payload[2]&flagNonECMultiply != flagNonECMultiply {
+		return nil, false, ErrInvalidKey
+	}
+	compressed := payload[2]&flagCompressed != 0
+	salt := payload[3:7]
+
+	derived, err := scrypt.Key(norm.NFC.Bytes([]byte(passphrase)), salt, 16384, 8, 8, 64)
+	if err != nil {
+		return nil, false, err
+	}
+	block, err := aes.NewCipher(derived[32:])
+	if err != nil {
+		return nil, false, err
+	}
+	key := make([]byte, 32)
+	block.Decrypt(key[:16], payload[7:23])
+	block.Decrypt(key[16:], payload[23:39])
+	for i := 0; i < 32; i++ {
+		key[i] ^= derived[i]
+	}
+
+	pk, _ := btcec.PrivKeyFromBytes(btcec.S256(), key)
+	if !bytes.Equal(addressHash(pk.PubKey(), compressed, pubKeyHashID), salt) {
+		return nil, false, ErrPassphrase
+	}
+	return pk, compressed, nil
+}
+
+// addressHash returns the first four bytes of the double SHA-256 of
+// the pay-to-pubkey-hash address of pub, whose version prefix
+// pubKeyHashID may be more than one byte.
+func addressHash(pub *btcec.PublicKey, compressed bool, pubKeyHashID []byte) []byte {
+	var serialized []byte
+	if compressed {
+		serialized = pub.SerializeCompressed()
+	} else {
+		serialized = pub.SerializeUncompressed()
+	}
+	payload := append(append([]byte{}, pubKeyHashID[1:]...), btcutil.Hash160(serialized)...)
+	addr := base58.CheckEncode(payload, pubKeyHashID[0])
+	return doubleSHA256([]byte(addr))[:4]
+}
+
+func paddedKey(pk *btcec.PrivateKey) []byte {
+	b := pk.D.Bytes()
+	return append(make([]byte, 32-len(b)), b...)
+}
+
+func doubleSHA256(b []byte) []byte {
+	first := sha256.Sum256(b)
+	second := sha256.Sum256(first[:])
+	return second[:]
+}
+
+// encode serializes payload in base58 with a checksum. The two-byte
+// prefixes of BIP38 keep base58.CheckEncode from being used.
+func encode(payload []byte) string {
+	return base58.Encode(append(payload, doubleSHA256(payload)[:4]...))
+}
+
+func decode(s string) ([]byte, error) {
+	return decodeLen(s, encryptedKeyLen)
+}
@@ -0,0 +1,174 @@
+// Copyright (C) 2014-15 Michail Kargakis
+// This source code is subject to the terms
+// of the MIT License
+
+package bip38
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcutil"
+)
+
+// mainnet is the pubkey-hash version of bitcoin addresses.
+var mainnet = []byte{0x00}
+
+// vectors are the BIP38 test vectors without EC multiply.
+var vectors = []struct {
+	passphrase, encrypted, wif string
+}{
+	// No compression.
+	{"TestingOneTwoThree", "6PRVWUbkzzsbcVac2qwfssoUJAN1Xhrg6bNk8J7Nzm5H7kxEbn2Nh2ZoGg", "5KN7MzqK5wt2TP1fQCYyHBtDrXdJuXbUzm4A9rKAteGu3Qi5CVR"},
+	{"Satoshi", "6PRNFFkZc2NZ6dJqFfhRoFNMR9Lnyj7dYGrzdgXXVMXcxoKTePPX1dWByq", "5HtasZ6ofTHP6HCwTqTkLDuLQisYPah7aUnSKfC7h4hMUVw2gi5"},
+	// Passphrase normalized as NFC.
+	{"ϓ\u0000\U00010400\U0001F4A9", "6PRW5o9FLp4gJDDVqJQKJFTpMvdsSGJxMYHtHaQBF3ooa8mwD69bapcDQn", "5Jajm8eQ22H3pGWLEVCXyvND8dQZhiQhoLJNKjYXk9roUFTMSZ4"},
+	// Compression.
+	{"TestingOneTwoThree", "6PYNKZ1EAgYgmQfmNVamxyXVWHzK5s6DGhwP4J5o44cvXdoY7sRzhtpUeo", "L44B5gGEpqEDRS9vVPz7QT35jcBG2r3CZwSwQ4fCewXAhAhqGVpP"},
+	{"Satoshi", "6PYLtMnXvfG3oJde97zRyLYFZCYizPU5T3LwgdYJz1fRhh16bU7u6PPmY7", "KwYgW8gcxj1JWJXhPSu4Fqwzfhp5Yfi42mdYmMa4XqK7NJxXUSK7"},
+}
+
+// ecVectors are the BIP38 test vectors with EC multiply, the last two
+// with lot and sequence numbers and confirmation codes.
+var ecVectors = []struct {
+	passphrase, intermediate, encrypted, address, wif, confirmation string
+	lot, sequence                                                   uint32
+}{
+	{"TestingOneTwoThree", "passphrasepxFy57B9v8HtUsszJYKReoNDV6VHjUSGt8EVJmux9n1J3Ltf1gRxyDGXqnf9qm", "6PfQu77ygVyJLZjfvMLyhLMQbYnu5uguoJJ4kMCLqWwPEdfpwANVS76gTX", "1PE6TQi6HTVNz5DLwB1LcpMBALubfuN2z2", "5K4caxezwjGCGfnoPTZ8tMcJBLB7Jvyjv4xxeacadhq8nLisLR2", "", 0, 0},
+	{"Satoshi", "passphraseoRDGAXTWzbp72eVbtUDdn1rwpgPUGjNZEc6CGBo8i5EC1FPW8wcnLdq4ThKzAS", "6PfLGnQs6VZnrNpmVKfjotbnQuaJK4KZoPFrAjx1JMJUa1Ft8gnf5WxfKd", "1CqzrtZC6mXSAhoxtFwVjz8LtwLJjDYU3V", "5KJ51SgxWaAYR13zd9ReMhJpwrcX47xTJh2D3fGPG9CM8vkv5sH", "", 0, 0},
+	{"MOLON LABE", "passphraseaB8feaLQDENqCgr4gKZpmf4VoaT6qdjJNJiv7fsKvjqavcJxvuR1hy25aTu5sX", "6PgNBNNzDkKdhkT6uJntUXwwzQV8Rr2tZcbkDcuC9DZRsS6AtHts4Ypo1j", "1Jscj8ALrYu2y9TD8NrpvDBugPedmbj4Yh", "5JLdxTtcTHcfYcmJsNVy1v2PMDx432JPoYcBTVVRHpPaxUrdtf8", "cfrm38V8aXBn7JWA1ESmFMUn6erxeBGZGAxJPY4e36S9QWkzZKtaVqLNMgnifETYw7BPwWC9aPD", 263183, 1},
+	{"ΜΟΛΩΝ ΛΑΒΕ", "passphrased3z9rQJHSyBkNBwTRPkUGNVEVrUAcfAXDyRU1V28ie6hNFbqDwbFBvsTK7yWVK", "6PgGWtx25kUg8QWvwuJAgorN6k9FbE25rv5dMRwu5SKMnfpfVe5mar2ngH", "1Lurmih3KruL4xDB5FmHof38yawNtP9oGf", "5KMKKuUmAkiNbA3DazMQiLfDq47qs8MAEThm4yL8R2PhV1ov33D", "cfrm38V8G4qq2ywYEFfWLD5Cc6msj9UwsG2Mj4Z6QdGJAFQpdatZLavkgRd1i4iBMdRngDqDs51", 806938, 1},
+}
+
+func TestVectors(t *testing.T) {
+	for _, v := range vectors {
+		wif, err := btcutil.DecodeWIF(v.wif)
+		if err != nil {
+			t.Fatal(err)
+		}
+		encrypted, err := Encrypt(wif.PrivKey, wif.CompressPubKey, v.passphrase, mainnet)
+		if err != nil {
+			t.Fatalf("%s: %v", v.wif, err)
+		}
+		if encrypted != v.encrypted {
+			t.Errorf("%s: encrypted %s, want %s", v.wif, encrypted, v.encrypted)
+		}
+		if got := decryptWIF(t, v.encrypted, v.passphrase); got != v.wif {
+			t.Errorf("%s: decrypted %s, want %s", v.encrypted, got, v.wif)
+		}
+		if _, _, err := Decrypt(v.encrypted, "wrong", mainnet); err != ErrPassphrase {
+			t.Errorf("%s: wrong passphrase: %v, want %v", v.encrypted, err, ErrPassphrase)
+		}
+	}
+}
+
+func TestECMultiplyVectors(t *testing.T) {
+	for _, v := range ecVectors {
+		// The owner salt is random, so rebuild the intermediate code
+		// from the salt of the vector.
+		payload, err := decodeLen(v.intermediate, intermediateCodeLen)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var code string
+		if v.lot != 0 {
+			code, err = intermediateCode(v.passphrase, payload[8:12], payload[12:16])
+		} else {
+			code, err = intermediateCode(v.passphrase, payload[8:16], nil)
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		if code != v.intermediate {
+			t.Errorf("%s: intermediate code %s, want %s", v.passphrase, code, v.intermediate)
+		}
+
+		if got := decryptWIF(t, v.encrypted, v.passphrase); got != v.wif {
+			t.Errorf("%s: decrypted %s, want %s", v.encrypted, got, v.wif)
+		}
+		pk, compressed, err := Decrypt(v.encrypted, v.passphrase, mainnet)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := address(t, pk.PubKey(), compressed); got != v.address {
+			t.Errorf("%s: address %s, want %s", v.encrypted, got, v.address)
+		}
+		if _, _, err := Decrypt(v.encrypted, "wrong", mainnet); err != ErrPassphrase {
+			t.Errorf("%s: wrong passphrase: %v, want %v", v.encrypted, err, ErrPassphrase)
+		}
+
+		if v.confirmation == "" {
+			continue
+		}
+		pub, compressed, err := VerifyConfirmation(v.confirmation, v.passphrase, mainnet)
+		if err != nil {
+			t.Fatalf("%s: %v", v.confirmation, err)
+		}
+		if got := address(t, pub, compressed); got != v.address {
+			t.Errorf("%s: confirmed address %s, want %s", v.confirmation, got, v.address)
+		}
+		if _, _, err := VerifyConfirmation(v.confirmation, "wrong", mainnet); err == nil {
+			t.Errorf("%s: confirmed with the wrong passphrase", v.confirmation)
+		}
+	}
+}
+
+func TestGenerateEncryptedKey(t *testing.T) {
+	for _, lot := range []uint32{0, 263183} {
+		var code string
+		var err error
+		if lot != 0 {
+			code, err = IntermediateCodeLotSequence("TestingOneTwoThree", lot, 1)
+		} else {
+			code, err = IntermediateCode("TestingOneTwoThree")
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, compressed := range []bool{false, true} {
+			key, err := GenerateEncryptedKey(code, compressed, mainnet)
+			if err != nil {
+				t.Fatal(err)
+			}
+			pk, c, err := Decrypt(key.Encrypted, "TestingOneTwoThree", mainnet)
+			if err != nil {
+				t.Fatalf("%s: %v", key.Encrypted, err)
+			}
+			if c != compressed || !pk.PubKey().IsEqual(key.PubKey) {
+				t.Errorf("%s: decrypted key does not match the generated one", key.Encrypted)
+			}
+			pub, c, err := VerifyConfirmation(key.Confirmation, "TestingOneTwoThree", mainnet)
+			if err != nil {
+				t.Fatalf("%s: %v", key.Confirmation, err)
+			}
+			if c != compressed || !pub.IsEqual(key.PubKey) {
+				t.Errorf("%s: confirmed key does not match the generated one", key.Confirmation)
+			}
+		}
+	}
+}
+
+func decryptWIF(t *testing.T, encrypted, passphrase string) string {
+	pk, compressed, err := Decrypt(encrypted, passphrase, mainnet)
+	if err != nil {
+		t.Fatalf("%s: %v", encrypted, err)
+	}
+	wif, err := btcutil.NewWIF(pk, &chaincfg.MainNetParams, compressed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return wif.String()
+}
+
+func address(t *testing.T, pub *btcec.PublicKey, compressed bool) string {
+	b := pub.SerializeUncompressed()
+	if compressed {
+		b = pub.SerializeCompressed()
+	}
+	addr, err := btcutil.NewAddressPubKey(b, &chaincfg.MainNetParams)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return addr.EncodeAddress()
+}
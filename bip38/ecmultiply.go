@@ -0,0 +1,328 @@
+// Copyright (C) 2014-15 Michail Kargakis
+// This source code is subject to the terms
+// of the MIT License
+
+package bip38
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"math/big"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcutil/base58"
+	"golang.org/x/crypto/scrypt"
+	"golang.org/x/text/unicode/norm"
+)
+
+// Magic bytes of intermediate and confirmation codes.
+var (
+	intermediateMagic            = []byte{0x2c, 0xe9, 0xb3, 0xe1, 0xff, 0x39, 0xe2, 0x53}
+	intermediateLotSequenceMagic = []byte{0x2c, 0xe9, 0xb3, 0xe1, 0xff, 0x39, 0xe2, 0x51}
+	confirmationMagic            = []byte{0x64, 0x3b, 0xf6, 0xa8, 0x9a}
+	ecMultiplyPrefix             = []byte{0x01, 0x43}
+)
+
+const (
+	flagLotSequence = 0x04
+
+	intermediateCodeLen = 8 + 8 + 33
+	confirmationCodeLen = 5 + 1 + 4 + 8 + 33
+
+	// MaxLot and MaxSequence bound the lot and sequence numbers an
+	// intermediate code can carry.
+	MaxLot      = 1<<20 - 1
+	MaxSequence = 1<<12 - 1
+)
+
+var (
+	// ErrInvalidIntermediate is returned for strings that are not
+	// passphrase intermediate codes.
+	ErrInvalidIntermediate = errors.New("bip38: invalid intermediate code")
+
+	// ErrInvalidConfirmation is returned for strings that are not
+	// confirmation codes.
+	ErrInvalidConfirmation = errors.New("bip38: invalid confirmation code")
+)
+
+// GeneratedKey is an encrypted key generated from an intermediate
+// code by someone who does not know the passphrase.
+type GeneratedKey struct {
+	// Encrypted is the BIP38 encrypted private key.
+	Encrypted string
+	// Confirmation lets the passphrase owner verify that Encrypted
+	// belongs to the address of PubKey.
+	Confirmation string
+	PubKey       *btcec.PublicKey
+	Compressed   bool
+}
+
+// IntermediateCode returns a passphrase intermediate code with a
+// random owner salt and no lot and sequence numbers.
+func IntermediateCode(passphrase string) (string, error) {
+	ownerSalt := make([]byte, 8)
+	if _, err := rand.Read(ownerSalt); err != nil {
+		return "", err
+	}
+	return intermediateCode(passphrase, ownerSalt, nil)
+}
+
+// IntermediateCodeLotSequence returns a passphrase intermediate code
+// carrying lot and sequence numbers, which end up in every key
+// generated from it.
+func IntermediateCodeLotSequence(passphrase string, lot, sequence uint32) (string, error) {
+	if lot > MaxLot || sequence > MaxSequence {
+		return "", errors.New("bip38: lot or sequence number out of range")
+	}
+	ownerSalt := make([]byte, 4)
+	if _, err := rand.Read(ownerSalt); err != nil {
+		return "", err
+	}
+	lotSequence := make([]byte, 4)
+	binary.BigEndian.PutUint32(lotSequence, lot<<12|sequence)
+	return intermediateCode(passphrase, ownerSalt, lotSequence)
+}
+
+func intermediateCode(passphrase string, ownerSalt, lotSequence []byte) (string, error) {
+	ownerEntropy := append(append([]byte{}, ownerSalt...), lotSequence...)
+	passFactor, err := passFactor(passphrase, ownerEntropy, lotSequence != nil)
+	if err != nil {
+		return "", err
+	}
+	magic := intermediateMagic
+	if lotSequence != nil {
+		magic = intermediateLotSequenceMagic
+	}
+	payload := append(append([]byte{}, magic...), ownerEntropy...)
+	payload = append(payload, passPoint(passFactor)...)
+	return encode(payload), nil
+}
+
+// GenerateEncryptedKey generates a new key from an intermediate code
+// and returns it encrypted along with its confirmation code.
+func GenerateEncryptedKey(intermediate string, compressed bool, pubKeyHashID []byte) (*GeneratedKey, error) {
+	payload, err := decodeLen(intermediate, intermediateCodeLen)
+	if err != nil {
+		return nil, ErrInvalidIntermediate
+	}
+	var flag byte
+	switch {
+	case bytes.Equal(payload[:8], intermediateMagic):
+	case bytes.Equal(payload[:8], intermediateLotSequenceMagic):
+		flag |= flagLotSequence
+	default:
+		return nil, ErrInvalidIntermediate
+	}
+	if compressed {
+		flag |= flagCompressed
+	}
+	ownerEntropy := payload[8:16]
+	passPoint, err := btcec.ParsePubKey(payload[16:], btcec.S256())
+	if err != nil {
+		return nil, ErrInvalidIntermediate
+	}
+
+	seedB := make([]byte, 24)
+	if _, err := rand.Read(seedB); err != nil {
+		return nil, err
+	}
+	factorB := doubleSHA256(seedB)
+	if !validScalar(factorB) {
+		return nil, errors.New("bip38: invalid seed, try again")
+	}
+
+	curve := btcec.S256()
+	x, y := curve.ScalarMult(passPoint.X, passPoint.Y, factorB)
+	pub := &btcec.PublicKey{Curve: curve, X: x, Y: y}
+	salt := addressHash(pub, compressed, pubKeyHashID)
+	derivedHalf1, derivedHalf2, err := deriveFromPassPoint(payload[16:], salt, ownerEntropy)
+	if err != nil {
+		return nil, err
+	}
+	block, err := aes.NewCipher(derivedHalf2)
+	if err != nil {
+		return nil, err
+	}
+
+	encryptedPart1 := make([]byte, 16)
+	block.Encrypt(encryptedPart1, xor(seedB[:16], derivedHalf1[:16]))
+	encryptedPart2 := make([]byte, 16)
+	block.Encrypt(encryptedPart2, xor(append(append([]byte{}, encryptedPart1[8:]...), seedB[16:]...), derivedHalf1[16:]))
+
+	key := append(append([]byte{}, ecMultiplyPrefix...), flag)
+	key = append(key, salt...)
+	key = append(key, ownerEntropy...)
+	key = append(key, encryptedPart1[:8]...)
+	key = append(key, encryptedPart2...)
+
+	pointB := pubKeyBytes(curve.ScalarBaseMult(factorB))
+	pointBX1 := make([]byte, 16)
+	block.Encrypt(pointBX1, xor(pointB[1:17], derivedHalf1[:16]))
+	pointBX2 := make([]byte, 16)
+	block.Encrypt(pointBX2, xor(pointB[17:], derivedHalf1[16:]))
+	confirmation := append(append([]byte{}, confirmationMagic...), flag)
+	confirmation = append(confirmation, salt...)
+	confirmation = append(confirmation, ownerEntropy...)
+	confirmation = append(confirmation, pointB[0]^(derivedHalf2[31]&0x01))
+	confirmation = append(confirmation, pointBX1...)
+	confirmation = append(confirmation, pointBX2...)
+
+	return &GeneratedKey{
+		Encrypted:    encode(key),
+		Confirmation: encode(confirmation),
+		PubKey:       pub,
+		Compressed:   compressed,
+	}, nil
+}
+
+// VerifyConfirmation checks a confirmation code against passphrase
+// and returns the public key of the encrypted key it was generated
+// with and whether that key is compressed.
+func VerifyConfirmation(confirmation, passphrase string, pubKeyHashID []byte) (*btcec.PublicKey, bool, error) {
+	payload, err := decodeLen(confirmation, confirmationCodeLen)
+	if err != nil || !bytes.Equal(payload[:5], confirmationMagic) {
+		return nil, false, ErrInvalidConfirmation
+	}
+	flag := payload[5]
+	compressed := flag&flagCompressed != 0
+	salt := payload[6:10]
+	ownerEntropy := payload[10:18]
+
+	passFactor, err := passFactor(passphrase, ownerEntropy, flag&flagLotSequence != 0)
+	if err != nil {
+		return nil, false, err
+	}
+	derivedHalf1, derivedHalf2, err := deriveFromPassPoint(passPoint(passFactor), salt, ownerEntropy)
+	if err != nil {
+		return nil, false, err
+	}
+	block, err := aes.NewCipher(derivedHalf2)
+	if err != nil {
+		return nil, false, err
+	}
+	pointB := make([]byte, 33)
+	pointB[0] = payload[18] ^ (derivedHalf2[31] & 0x01)
+	block.Decrypt(pointB[1:17], payload[19:35])
+	block.Decrypt(pointB[17:], payload[35:51])
+	copy(pointB[1:], xor(pointB[1:], derivedHalf1))
+
+	curve := btcec.S256()
+	b, err := btcec.ParsePubKey(pointB, curve)
+	if err != nil {
+		return nil, false, ErrPassphrase
+	}
+	x, y := curve.ScalarMult(b.X, b.Y, passFactor)
+	pub := &btcec.PublicKey{Curve: curve, X: x, Y: y}
+	if !bytes.Equal(addressHash(pub, compressed, pubKeyHashID), salt) {
+		return nil, false, ErrPassphrase
+	}
+	return pub, compressed, nil
+}
+
+// decryptECMultiply decrypts a key produced by GenerateEncryptedKey.
+func decryptECMultiply(payload []byte, passphrase string, pubKeyHashID []byte) (*btcec.PrivateKey, bool, error) {
+	flag := payload[2]
+	compressed := flag&flagCompressed != 0
+	salt := payload[3:7]
+	ownerEntropy := payload[7:15]
+
+	passFactor, err := passFactor(passphrase, ownerEntropy, flag&flagLotSequence != 0)
+	if err != nil {
+		return nil, false, err
+	}
+	derivedHalf1, derivedHalf2, err := deriveFromPassPoint(passPoint(passFactor), salt, ownerEntropy)
+	if err != nil {
+		return nil, false, err
+	}
+	block, err := aes.NewCipher(derivedHalf2)
+	if err != nil {
+		return nil, false, err
+	}
+
+	// The second encrypted part holds the tail of the first one
+	// followed by the last eight bytes of seedb.
+	part2 := make([]byte, 16)
+	block.Decrypt(part2, payload[23:39])
+	part2 = xor(part2, derivedHalf1[16:])
+	encryptedPart1 := append(append([]byte{}, payload[15:23]...), part2[:8]...)
+	seedB := make([]byte, 16)
+	block.Decrypt(seedB, encryptedPart1)
+	seedB = append(xor(seedB, derivedHalf1[:16]), part2[8:]...)
+
+	n := new(big.Int).SetBytes(passFactor)
+	n.Mul(n, new(big.Int).SetBytes(doubleSHA256(seedB)))
+	n.Mod(n, btcec.S256().N)
+	if n.Sign() == 0 {
+		return nil, false, ErrPassphrase
+	}
+	b := n.Bytes()
+	pk, _ := btcec.PrivKeyFromBytes(btcec.S256(), append(make([]byte, 32-len(b)), b...))
+	if !bytes.Equal(addressHash(pk.PubKey(), compressed, pubKeyHashID), salt) {
+		return nil, false, ErrPassphrase
+	}
+	return pk, compressed, nil
+}
+
+// passFactor derives the passfactor of passphrase. Intermediate codes
+// with lot and sequence numbers salt it with the first four bytes of
+// the owner entropy only.
+func passFactor(passphrase string, ownerEntropy []byte, lotSequence bool) ([]byte, error) {
+	ownerSalt := ownerEntropy
+	if lotSequence {
+		ownerSalt = ownerEntropy[:4]
+	}
+	preFactor, err := scrypt.Key(norm.NFC.Bytes([]byte(passphrase)), ownerSalt, 16384, 8, 8, 32)
+	if err != nil {
+		return nil, err
+	}
+	if !lotSequence {
+		return preFactor, nil
+	}
+	return doubleSHA256(append(preFactor, ownerEntropy...)), nil
+}
+
+func passPoint(passFactor []byte) []byte {
+	return pubKeyBytes(btcec.S256().ScalarBaseMult(passFactor))
+}
+
+func deriveFromPassPoint(passPoint, salt, ownerEntropy []byte) ([]byte, []byte, error) {
+	derived, err := scrypt.Key(passPoint, append(append([]byte{}, salt...), ownerEntropy...), 1024, 1, 1, 64)
+	if err != nil {
+		return nil, nil, err
+	}
+	return derived[:32], derived[32:], nil
+}
+
+func pubKeyBytes(x, y *big.Int) []byte {
+	return (&btcec.PublicKey{Curve: btcec.S256(), X: x, Y: y}).SerializeCompressed()
+}
+
+func validScalar(b []byte) bool {
+	n := new(big.Int).SetBytes(b)
+	return n.Sign() != 0 && n.Cmp(btcec.S256().N) < 0
+}
+
+func xor(a, b []byte) []byte {
+	out := make([]byte, len(a))
+	for i := range a {
+		out[i] = a[i] ^ b[i]
+	}
+	return out
+}
+
+// decodeLen decodes a base58 string with a checksum expected to
+// carry an n-byte payload.
+func decodeLen(s string, n int) ([]byte, error) {
+	b := base58.Decode(s)
+	if len(b) != n+4 {
+		return nil, ErrInvalidKey
+	}
+	payload := b[:n]
+	if !bytes.Equal(doubleSHA256(payload)[:4], b[n:]) {
+		return nil, ErrInvalidKey
+	}
+	return payload, nil
+}
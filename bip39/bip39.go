@@ -0,0 +1,165 @@
+// Copyright (C) 2014-15 Michail Kargakis
+// This source code is subject to the terms
+// of the MIT License
+
+// Package bip39 implements mnemonic sentences for the generation
+// of deterministic keys as specified in BIP39.
+package bip39
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/sha512"
+	"errors"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/pbkdf2"
+	"golang.org/x/text/unicode/norm"
+)
+
+var (
+	// ErrEntropySize is returned when the entropy size is not a
+	// multiple of 32 bits between 128 and 256 bits.
+	ErrEntropySize = errors.New("bip39: entropy must be 128-256 bits and a multiple of 32")
+
+	// ErrWordCount is returned for a mnemonic that is not 12, 15, 18,
+	// 21 or 24 words long.
+	ErrWordCount = errors.New("bip39: mnemonic must be 12, 15, 18, 21 or 24 words")
+
+	// ErrChecksum is returned when the checksum of a mnemonic does
+	// not match its entropy.
+	ErrChecksum = errors.New("bip39: invalid mnemonic checksum")
+)
+
+const (
+	seedIterations = 2048
+	seedSize       = 64
+)
+
+var (
+	wordList  []string
+	wordIndex map[string]int
+)
+
+func init() {
+	words, err := englishWords()
+	if err != nil {
+		panic(err)
+	}
+	wordList = words
+	wordIndex = make(map[string]int, len(words))
+	for i, w := range words {
+		wordIndex[w] = i
+	}
+}
+
+// EntropyBits returns the entropy size in bits for a mnemonic
+// of the given number of words.
+func EntropyBits(words int) (int, error) {
+	switch words {
+	case 12, 15, 18, 21, 24:
+		return words * 32 / 3, nil
+	}
+	return 0, ErrWordCount
+}
+
+// NewEntropy returns bitSize bits of random entropy read from
+// crypto/rand.
+func NewEntropy(bitSize int) ([]byte, error) {
+	if err := validateEntropySize(bitSize); err != nil {
+		return nil, err
+	}
+	entropy := make([]byte, bitSize/8)
+	if _, err := rand.Read(entropy); err != nil {
+		return nil, err
+	}
+	return entropy, nil
+}
+
+// NewMnemonic encodes entropy as a mnemonic sentence.
+func NewMnemonic(entropy []byte) (string, error) {
+	if err := validateEntropySize(len(entropy) * 8); err != nil {
+		return "", err
+	}
+	// Every word encodes 11 bits of entropy followed by the first
+	// len(entropy)/4 bits of its SHA-256 digest.
+	checksum := sha256.Sum256(entropy)
+	data := append(append([]byte{}, entropy...), checksum[0])
+	n := (len(entropy)*8 + len(entropy)/4) / 11
+	words := make([]string, n)
+	for i := range words {
+		words[i] = wordList[bits11(data, i*11)]
+	}
+	return strings.Join(words, " "), nil
+}
+
+// EntropyFromMnemonic decodes a mnemonic sentence back to its
+// entropy after checking its words and checksum.
+func EntropyFromMnemonic(mnemonic string) ([]byte, error) {
+	words := strings.Fields(mnemonic)
+	bitSize, err := EntropyBits(len(words))
+	if err != nil {
+		return nil, err
+	}
+	data := make([]byte, bitSize/8+1)
+	for i, w := range words {
+		idx, ok := wordIndex[w]
+		if !ok {
+			return nil, fmt.Errorf("bip39: unknown word %q", w)
+		}
+		for b := 0; b < 11; b++ {
+			if idx&(1<<uint(10-b)) != 0 {
+				pos := i*11 + b
+				data[pos/8] |= 0x80 >> uint(pos%8)
+			}
+		}
+	}
+	entropy := data[:bitSize/8]
+	checksum := sha256.Sum256(entropy)
+	mask := byte(0xff) << uint(8-bitSize/32)
+	if data[bitSize/8]&mask != checksum[0]&mask {
+		return nil, ErrChecksum
+	}
+	return entropy, nil
+}
+
+// IsMnemonicValid reports whether mnemonic is made of words from
+// the wordlist and carries a valid checksum.
+func IsMnemonicValid(mnemonic string) bool {
+	_, err := EntropyFromMnemonic(mnemonic)
+	return err == nil
+}
+
+// NewSeed derives the 64-byte seed of a mnemonic sentence protected
+// by an optional passphrase. The mnemonic is not validated.
+func NewSeed(mnemonic, passphrase string) []byte {
+	password := norm.NFKD.String(strings.Join(strings.Fields(mnemonic), " "))
+	salt := norm.NFKD.String("mnemonic" + passphrase)
+	return pbkdf2.Key([]byte(password), []byte(salt), seedIterations, seedSize, sha512.New)
+}
+
+// WordList returns a copy of the English wordlist.
+func WordList() []string {
+	return append([]string{}, wordList...)
+}
+
+func validateEntropySize(bitSize int) error {
+	if bitSize < 128 || bitSize > 256 || bitSize%32 != 0 {
+		return ErrEntropySize
+	}
+	return nil
+}
+
+// bits11 returns the 11 bits of data starting at bit offset.
+func bits11(data []byte, offset int) int {
+	var idx int
+	for b := 0; b < 11; b++ {
+		pos := offset + b
+		idx <<= 1
+		if data[pos/8]&(0x80>>uint(pos%8)) != 0 {
+			idx |= 1
+		}
+	}
+	return idx
+}
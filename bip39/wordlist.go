@@ -0,0 +1,48 @@
+// Copyright (C) 2014-15 Michail Kargakis
+// This source code is subject to the terms
+// of the MIT License
+
+package bip39
+
+import (
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// englishSHA256 is the SHA-256 digest of english.txt as published
+// in the BIP39 specification.
+const englishSHA256 = "2f5eed53a4727b4bf8880d8f3f199efc90e58503646d9ff8eff3a2ed3b24dbda"
+
+// English wordlist in gzipped bytes, embedded the same
+// way as the coin logos.
+var (
+	englishWordsBytes = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x2c\x5b\xdb\x9a\xf3\x2a\x08\xbd\xe7\x2d\x49\x24\x09\x53\x05\x37\x6a\x33\x99\xa7\xdf\xdf\x22\xff\x45\x16\x1e\x10\x11\xf1\xdc\xf2\xc6\x56\xdc\x88\x37\xad\x3a\x1f\xe2\xad\x0a\xf1\xe6\x6b\x02\xbf\x08\x0f\x31\x44\x86\xc7\x06\x32\x83\xf7\x8c\xaf\x28\xc4\xdb\x1a\x42\xbc\xef\x32\x06\x88\x96\x64\xde\x77\x5f\x2f\x7d\xb3\x2f\x15\x88\xda\xb5\x10\xef\xbe\xc6\xd4\x9d\x78\xff\x6f\x69\x20\x35\x3c\x0b\x83\x7f\x2a\x74\xd9\xa7\x07\x30\x5e\xa9\x73\x71\x25\x2e\xdc\x27\x71\x29\xf8\x74\xcf\xe0\x9b\x5f\x7e\xd6\x40\xb4\x29\x70\x55\xe0\x97\x6d\x17\x50\x05\x91\xf0\x0d\x35\x1e\x07\x6b\x80\x38\x54\x3f\x82\xa1\xcf\xc9\x6a\xc4\xa7\xe0\x83\xce\x67\x88\x10\x5f\xc2\x85\x58\x1b\x65\x09\x8d\xee\x31\x89\x75\xc0\x3a\x95\xa3\x11\xd7\x6d\x01\x77\xbf\xbc\x12\x57\x41\x7e\x55\x31\xe2\x8a\x78\x95\x07\xe8\x37\x71\x6d\x0e\x05\xab\x1b\x0a\xf7\x8b\x89\x6b\x08\x17\x30\x0c\x27\xae\x53\x82\xb8\xde\xfc\x0c\xe2\xc6\x53\x56\x80\xfe\xa9\x9d\xc4\xcd\x5f\x4c\x7b\xb6\x35\xa4\x10\x1b\xd7\x07\x12\x6d\xbf\x60\x28\xdb\x35\x35\xb7\x13\x72\xec\x84\x8e\x76\xc6\x43\x6c\xda\x60\x3a\xfb\x64\x92\xf9\x4a\xab\x98\xa5\x41\xcd\xe7\x95\x05\xc6\x9d\x64\x8a\x19\x83\xea\x7f\x0b\x5c\xbf\x2a\x70\x08\x7b\x88\x3b\xa3\x75\xdd\xab\x9f\x88\x75\xe1\x00\x81\xd4\xde\x23\xdd\xa4\x87\x56\xe2\xd8\x2f\x40\xf6\x6f\x08\x03\x20\x33\x4e\x48\x84\xd5\xa2\xa1\x01\xd1\xa0\x77\xb4\x87\x38\x7c\x19\x52\x02\xea\x83\x0a\x5a\x16\xa1\xdf\x8c\xc1\x7e\xa8\x3b\xa6\x1c\xe9\x22\x31\x35\x19\xe6\xed\xf1\x21\x1e\xf8\xba\x20\x67\x0c\xce\xce\x1f\x43\x12\x93\x6f\x8c\xd5\x84\x78\xcc\xab\x31\xf1\xbc\xaa\x4c\x21\x9e\xde\x88\xe7\xe4\xfd\x03\x22\x50\x60\x4e\x9d\xab\x20\xef\x9f\x83\xaf\x7f\xce\xb8\x8a\x22\x76\xa6\x93\x65\x2f\xac\x99\x66\x5f\xd3\x01\xab\x19\xf1\x57\x22\x5d\xe8\xeb\x3b\x17\x07\x85\x6b\xdd\xfc\x11\x60\x24\x3e\xc4\xb7\x0c\x87\x3a\xf7\xb1\x2a\xf1\xfd\xb9\x19\x8e\xf8\xab\x83\x36\xde\x1e\xda\x78\xbf\xa4\x7a\x20\xe0\x46\x1b\x97\x53\x68\xe3\x93\x36\xae\xe9\xd0\x1b\xfc\xcd\xc0\x58\x2b\x6d\xdc\x36\x77\xda\xd8\xd8\x18\xc4\x04\x25\xf3\x93\x0a\xa6\x48\xdf\xde\x60\x54\xb0\x0f\x08\x18\xba\x03\x3f\x32\x69\xe3\x39\xab\xd0\x26\xbc\x5f\x40\x03\xac\xf9\xd0\x26\x3b\x2f\x70\xcb\x0e\x75\x37\x91\x83\x36\x39\x3c\x10\x3e\x21\x52\x2e\xfe\x22\x72\xa9\x15\xda\xa4\xe6\xf8\xde\x04\xee\xbe\x49\x9d\xb4\x89\xa5\x4c\x93\x43\x11\x1b\x80\x19\x0c\xd9\x73\x42\x4f\x99\xb7\x08\x24\x3d\x0e\x11\xba\x3f\x3b\x74\x51\x84\x3f\x08\x64\xea\xeb\x71\x9b\x06\x22\x31\x2f\xda\xf4\x2d\x5e\xd1\x77\x5b\xe5\x22\xc0\x96\x68\xd9\xa8\xca\xa8\xac\x0a\x23\x5f\xc6\xa0\xad\xa6\xac\xea\x9e\x38\x86\x37\xd0\x6c\x60\x5d\x09\x10\xb8\xc6\x45\x9b\xa3\x43\x36\xe7\x49\x9b\x97\x87\x36\xd7\x4a\x9b\xb7\x8d\x36\x37\x01\xac\x41\x9b\xfb\x07\x80\x6a\x3c\x0a\xb4\xf1\xc0\x58\xdd\x3c\xfd\x75\x73\x54\xea\x13\x4e\xb6\xbd\x43\x6e\xf3\x5f\xda\xfc\xa1\x2d\x78\x4f\x25\x23\x3b\x26\x18\x8a\x05\x83\x3f\xd2\xa0\x21\x8c\x14\x91\x3f\x44\x14\x6d\x0c\x4d\x2f\x08\x45\x27\x84\x9e\x17\x8a\x67\x75\xa1\x03\xf9\xbe\xef\x5e\x15\x81\x0f\x0c\x1a\x6e\x59\xd8\x51\x7d\xbc\x63\x7c\x0b\xbf\x91\x95\x6d\x5c\x1b\xe6\xf8\x6d\x15\x34\x70\x95\x13\xfa\xac\xe3\xe0\xea\xb4\x2d\xad\x85\xb6\x55\x37\xc0\x07\x50\x33\xdb\x4a\x16\xb1\x0f\x84\xad\x28\xa8\x68\xc5\xf9\xc6\x60\x08\xd8\x65\x0d\xb5\x34\xf8\x1a\x90\xfc\x76\xd4\x7a\x12\xff\xfe\x68\xe7\x6d\xc3\x20\xd9\x79\x53\x03\x56\x84\xf7\xb9\x06\xed\x6f\xfa\x07\x50\x2b\xa0\xd1\xce\x4d\x82\x41\x3a\xed\x8c\x02\xc6\xc8\xb2\x5d\x92\x94\x07\x68\x9e\x39\x8e\x92\xf6\x65\x88\xb2\x27\xd3\xfa\xbf\x0a\xba\xce\x2c\xd7\x27\x67\xb5\x81\x6f\x4b\x96\x28\x80\xd3\x81\x5d\x26\x48\x40\x6a\x20\x38\x50\x78\x5c\x00\x35\xb0\x8c\x99\xf2\xc6\x4a\x71\x60\x99\x5c\xfd\x04\xdd\xc1\x36\xe5\xf4\x2c\x3e\x5f\xc6\x85\xae\x7a\x07\xd2\xce\x2b\x27\x93\x1d\x9d\xbc\x8b\x56\xf4\xdf\x2e\x55\xc0\x2f\x4d\x6c\xd2\x2e\x36\x60\x09\xb1\xb9\x32\x35\x04\xf5\x48\xbc\x6a\x5f\xac\x01\xac\x1f\x60\xeb\x29\xed\xca\x39\x73\xbf\xd8\x07\xb0\x4f\x49\x9e\x78\x13\x47\xe2\xa4\xfd\x12\xee\xc0\x1d\x65\x45\x32\x5d\x0e\x40\x36\xf7\x92\x01\x26\xdd\xe1\x3e\x58\xaa\x91\x05\x3f\xd8\x2f\x6d\x26\xe0\x70\xdd\x51\xc8\x3d\xcb\x86\x9b\xee\xb4\x5f\x6b\xc7\xba\xb2\x5f\xcb\x20\x78\x85\xd1\xae\x27\xec\xab\x66\x58\xb7\x68\xd7\xc0\xa0\xde\x75\xea\x1f\x64\xeb\x7c\x68\xd7\xaf\x56\xda\x2b\x6b\x03\x76\x40\xe8\xf1\x80\xde\x00\x84\x04\xfd\x5d\x25\x3e\xc0\x2f\x5a\x55\x31\x16\xf6\x9a\x0b\xdd\x5e\xf5\x38\x80\x6d\x03\xa6\x32\x55\x21\xc9\x93\x09\x7d\x52\x53\xd5\xea\xf3\x02\xae\x02\xbc\x21\x74\xa1\xcc\x82\x4f\xd5\x35\xd2\x60\x75\x65\xff\x39\xbf\x08\x63\xf8\xee\xb6\x40\x8b\xd0\xee\xc7\x21\x20\x50\xdb\xd1\x17\x5e\xab\xec\xc8\xad\x1e\x40\xac\x00\xbb\xb7\x4d\x0d\x6c\x2d\xe1\x70\x38\x91\x37\xe8\xe6\x2d\x8d\xe1\xad\x63\x31\xdd\xdd\xd0\xab\xa0\x65\xa5\x18\x3b\x34\x1a\xe8\x99\x1b\x9a\xdd\xcd\x5e\xf9\x36\xb4\x40\x43\xb7\x19\x8e\xda\xed\xab\x86\x9e\xc0\x0c\xb4\x7b\x26\xf5\x9e\x1c\x1d\x82\x03\x1e\xe3\x01\x06\x74\x86\x47\xbc\x72\xb2\x49\x73\xa6\x12\x2b\x5b\xb9\x6c\xa2\xeb\x7d\x75\xf4\x8f\xaf\x18\x49\x46\x36\x2f\x0d\xee\x8f\x4f\xa1\x1d\x13\x16\xb0\xd4\x8c\x1c\x13\xd8\x00\x96\x09\x18\x20\xc1\x69\xc7\xe0\xbb\x02\xff\x1e\xda\x43\x92\x49\x8a\xa2\x80\x08\x64\xc8\x4d\x3b\xa6\x34\x41\x92\xc2\x4c\xa1\xa3\x03\x27\xac\x14\x8e\xb0\xc3\x00\xf1\x6a\x19\x7e\x17\xda\x63\xed\x8a\x76\xc5\x92\x44\x85\xaa\xb1\x5a\x8e\xee\x58\x96\x9c\x2b\xf5\x40\xc5\xcf\xc8\xe1\xbe\x36\xa1\x7d\xd5\xb9\x60\x8d\xd5\xf1\xbd\x93\xfb\xbe\x42\x1d\x03\x6d\x45\xa4\x33\xad\x7f\x23\x6c\x05\xc6\xe6\x1a\x57\x0e\xad\x35\xa6\x37\xda\x17\x6c\x90\x2b\x53\xe1\x42\x85\x1b\x9f\x08\xb6\x4e\x25\x17\xe4\xf2\x6e\xba\x0a\xe7\x8c\x5c\x60\x8e\x92\x03\x3f\x13\x6f\xa3\xc2\x0f\x15\xe1\x4a\x45\x36\x9e\x02\x12\x3a\xa8\xc8\xce\x05\xb1\x5d\xda\x06\x5e\xd9\xf5\x8d\x57\xb5\xa4\x1e\x2f\x3b\x4c\x39\x10\x48\xae\x43\x2c\x23\xc7\xcb\x75\x40\x78\x6e\x58\x8b\xd4\xac\xa9\xea\x37\x19\x1b\x5b\x01\xd1\x64\x37\x4d\x0d\x2c\xf7\x4e\x45\x0c\x9c\xb9\xa7\x2b\xd2\x25\x19\xbb\x0f\xcd\xe8\xbc\x80\xd8\x05\x14\xc9\x4d\x58\x91\xb1\x87\x6e\x19\x90\x2c\x32\xf4\x34\x90\x0f\xa0\xb3\xa2\xbe\x31\xc3\x51\x64\xb2\xa2\xa6\x29\x3b\x38\xbf\x52\xbd\x83\x2a\x6c\x25\x5f\x47\x93\x94\x4f\xb8\x50\x49\x9d\x14\x13\x45\x01\x8d\x87\x4a\xb2\xa9\x0c\x41\x8e\x4c\x2a\x7a\x1c\x68\x8e\x9e\x39\x89\x17\x3d\x0d\x73\x48\xd1\x2a\xad\x31\x15\xcd\x3d\x4f\x51\xf3\xc1\x0b\x81\x74\xf8\xa2\x50\x53\xc7\xbb\x95\x2f\x3a\x5e\x97\x2e\x3a\x5e\x53\x2a\x7a\x49\x47\xd3\x31\x40\xdf\x35\xbc\xe8\xe8\x69\x42\x1d\xf3\xed\x5a\x58\x12\x72\xbe\xd9\x33\xfa\xf5\xc8\xd4\xbf\xbf\x87\x8a\xe7\x11\xa5\xf8\xbe\x72\xf2\x2e\x7e\x52\xf1\x5a\x01\xfd\x52\xa3\xe2\x8d\x93\x58\xf6\xa2\xdb\x47\x50\xca\xb2\x50\x02\x14\xf1\x05\x27\x2e\x0e\x2b\xe7\xd8\x2a\xc1\xa7\x1b\x08\x5a\x17\x9c\x07\xa5\x12\x7c\x53\xc9\x11\xf5\x9e\x78\x4a\x68\xf2\x2a\x2a\x0c\xb5\x0f\xb0\x03\x52\x10\x2c\x1e\x0b\xcc\x0f\x95\xb5\x7f\xa8\xac\xb6\x51\x59\x26\x54\xd6\xeb\xa7\x0b\x6e\x90\xd3\x5e\xc9\x9e\xbe\x39\x0e\x2a\x8f\x31\xe6\x2b\x61\xb8\xb4\xf0\x59\x85\x84\xa3\x3e\x40\x03\xcc\x8b\x84\x87\x66\xca\x98\x80\x87\x64\xbf\x9c\x64\x7f\x37\x6a\xb2\xbb\x79\x7b\x48\xca\x29\x94\x83\x5f\xca\xda\x79\x0a\xc9\x91\x33\xa2\x9c\x27\x49\xee\x61\x44\x73\x67\x22\x75\xf3\x9b\xa4\x96\x0c\xcb\x3e\x03\x1a\x54\x39\xd9\x26\x68\x9a\x57\xaa\xf4\xeb\x5f\xc2\x97\x61\x79\xa9\x0a\xa1\x75\x08\x49\xdb\x38\x3e\x20\xd8\xb1\x49\xc3\x1e\x0b\xa9\x12\xd0\xa1\x79\x2e\xbc\xd2\x7a\x75\xe4\x76\xbf\x51\x53\xeb\xf3\x21\xb1\xdc\x22\x88\xf1\x3e\x49\xac\xe0\xcb\x1d\xa3\x58\xf1\x80\x68\x13\x34\xc6\x24\xd0\x36\x3b\xd2\x03\xc4\x4e\x3e\x93\xa8\x81\x5c\xe9\x2d\x62\x3f\x90\x6f\x55\x07\x64\xf9\x3a\x2f\x12\x0b\xdd\x93\xc0\x35\xc4\xc6\x0a\x30\x4e\x09\xa0\xbe\x91\x40\xa9\x1c\x27\x42\xd2\x75\x78\x11\x92\xff\x16\x57\xa0\x76\x92\x60\x7c\x43\x48\x22\xf3\xc2\x47\xb6\x28\xc2\x83\x24\x56\x9f\x24\x63\x67\x14\x1f\x83\x1f\xa0\xa4\x46\x63\xa6\xe1\xa7\x84\x41\xda\xbc\x74\x1f\x24\xf0\xe6\xcc\xc6\x32\x2c\x5f\xff\x20\xe8\xf5\x2b\x24\xbf\x69\x87\x5f\x6e\xbd\x22\x96\x67\x7a\xf9\xfd\xb7\xcb\x90\xdf\x3d\x4d\xfe\xbb\xd7\x55\x92\xae\x01\x22\x39\x47\xca\xaf\xc4\xae\x99\x70\x31\xbc\x4b\x7e\x2f\xdd\x14\x54\x53\x58\x9a\xe5\x37\x13\x1c\x4e\x2d\xbf\x9d\x61\xf1\xdf\x3c\xbb\xc9\x6f\x4f\x73\xfc\xf6\xca\x6a\xa0\x9e\xb2\x7a\xbc\x3a\xe4\x11\x4d\x7e\x67\x30\xc9\x23\xf8\xb0\xa3\xa5\x83\xb1\x47\xa6\x03\x1d\x7e\x30\xa6\xfb\x87\x0e\x2e\x88\xa8\x4d\xe0\xbc\xe8\xe0\x5a\x01\x03\xc9\x2d\x01\x1e\x7c\xe0\x4c\x3d\xe8\x60\xc3\xb7\x23\xc1\x26\x1c\xfa\xe0\x68\x74\xf0\xbb\x0e\x1c\x0c\x31\x93\x21\x21\xfd\xf5\xe0\xa9\xe7\x82\x94\x55\x91\xf5\xf5\xd0\x29\x74\x08\xe7\x52\x73\xc8\x16\x0b\x53\xd9\x21\x45\x02\xc5\x04\x89\x52\x00\x88\x35\xae\x48\x40\x0f\x1c\x32\xa6\x7e\x93\x07\x23\xf1\xc8\x8d\xce\x21\x37\x1d\xba\x21\xa4\xef\x01\xf4\x50\xa9\x85\x0e\x3d\x53\x3e\xac\x79\x68\x6d\x80\x99\x5c\xe8\xdc\x43\x0d\x2c\x86\xbc\x5c\x90\x0e\x35\xcc\x72\x87\x66\x19\x34\x48\x63\x4c\x3a\x74\xec\xc9\x9e\x79\x88\xcf\xdc\xb1\x1f\xfa\x4b\x47\xe5\x13\x00\x13\x55\x2c\x64\x47\x45\xe3\x2b\x9a\x48\x47\x45\x3b\x6a\x8e\xdc\xa3\x6a\xa7\xa3\x7a\xe6\x62\xc7\x75\x54\x4f\x96\x1c\x5a\x47\x5d\x5a\x80\x29\xe1\xa1\xc3\xb9\xd1\xe1\x3b\x6c\xed\x27\x1d\xae\x95\x0e\x47\x8b\x3c\x6f\x47\x0e\x77\x84\x7d\xd2\x3b\xbc\x0e\x0f\x19\x19\x39\x25\xc9\x07\x30\x97\x65\xd6\x82\xac\xc8\x63\xf3\xe1\x63\xa4\xac\xdc\xc5\x1d\x79\x8d\x70\xf8\x2f\x1d\xc1\x67\x5a\x29\xb2\x29\x21\xff\x2d\x81\x33\x84\x40\xa3\x50\x01\x1f\xa6\x41\x64\x42\xa3\xf0\xcc\xf6\x91\x78\x1b\x10\xdb\xd5\x23\x16\x4c\xb4\xd0\x6f\xcb\xf0\xd9\x43\xc7\x0a\x4b\x77\xc3\x26\xfd\x58\xd9\xe9\x27\xe7\x21\x2a\x0f\xdb\x27\x57\xfe\x7d\x40\x72\x73\x7f\x42\x87\x93\x3b\x9d\x9c\x17\x04\x27\xc7\xf6\x8f\x16\x01\x77\x54\xdd\x41\x72\x9e\x3b\x79\xe0\x03\xf7\x04\x4f\x7a\xdc\xc9\x2b\x0b\xfc\x09\x9d\x62\xe9\x54\xa7\x98\xae\x01\x12\x99\x38\x6b\x92\xa5\x06\x3a\x5e\xa5\x2e\x34\xe8\x54\x86\x5c\xac\x17\xa7\x9e\x98\xd1\xcf\xd7\x41\x4e\x0d\x3e\x0e\x44\xa3\xd2\xa9\x5f\xa1\xb3\x72\x01\xd8\x9e\xe1\x48\x1c\x83\xce\xaa\x05\x61\x6d\x7d\x80\xfa\x96\xe8\x0d\x88\x26\x56\xcf\xd2\x7e\xd3\x59\x97\xd0\xe9\x3c\xe9\xf4\x52\x04\x85\xd1\xd3\x27\xba\xf8\xcc\xc3\xc3\xe9\x58\xb5\x98\x4e\x1f\x5d\x2a\xc8\xd0\x4e\x27\xd6\x67\xa3\x13\xc6\x3f\x83\x37\x00\xb4\xc8\x53\xf2\x19\xd9\x84\xe0\x9e\x29\x10\x1a\xfc\xd5\xf9\xd0\x19\x82\xaa\x42\x60\xc9\xd0\x02\x90\x03\x88\x54\xdf\xb3\x03\xc2\x57\x07\xde\x74\xc6\x82\xa0\x05\xe7\x39\x57\x6a\xb7\xb2\x69\x4b\x2b\xd2\x75\x72\xd0\xb9\x8c\xce\xa7\xd1\xc5\x98\xbd\xf2\xd4\x75\x71\x3d\xe8\xe2\xd6\x04\xe1\x96\xee\x76\xb1\x15\xba\xb8\xf7\x87\x2e\x9c\x26\x91\x12\x48\x89\x71\x01\xbf\x32\x50\x1a\xdf\x57\xe8\xe2\xfb\x43\x17\xff\x25\x8b\x70\x42\x9d\x17\x48\x4c\xe0\xf7\xa1\x0b\x6b\xe6\xe5\x27\x5d\xef\xfa\x78\x49\xad\x0e\x6c\x92\x91\x4e\x97\x18\x5d\x12\x4e\x97\x96\x82\xb0\x9e\x17\x5d\x5a\x2b\x5d\x6a\x93\x2e\xed\x74\x69\x08\x5d\x3a\x26\x3a\xe6\xf2\x6d\x03\xee\xd8\x7b\x5c\xe8\x88\xcb\xab\x00\x14\xbb\xd2\xeb\x1d\x7f\x97\x37\xa4\x59\xf2\x38\x78\x3a\xe2\x61\x80\x40\xc3\x72\x29\xbc\x7c\xbc\x07\xe9\x74\xab\xcb\xa7\x20\xb8\x90\xfd\x85\x3d\xd6\x46\x17\xfc\xf4\x5a\x8d\x0d\x88\x95\xf5\x5a\x0d\x02\x96\x95\x90\x02\x8a\x4b\xc9\x0b\xbd\x70\xad\x28\xc9\x10\x99\x02\x2b\xac\xb1\xa5\x51\x1f\xdc\x78\x90\xee\x42\xba\xbb\x91\x16\x61\xca\x6b\x65\x1c\x12\x15\xa5\xf4\x34\x0f\x21\xb4\x5c\x2b\xb6\x0a\x49\x73\x22\xd3\xdc\xa0\x6b\xd3\x5c\xfb\xb4\x35\xb1\x91\x74\x19\x48\xe7\x7d\x82\x78\x26\xbe\x37\x97\xda\xfa\xaa\x88\xe3\x24\xa1\xf6\x2e\x6f\x6a\x79\x90\x53\xfb\xb7\xfd\x56\x2b\xf2\x0b\xd4\xdc\xcd\xa8\xe5\x2e\x4e\x0d\x3b\xa9\x78\x48\x0d\xab\x07\x48\xd5\x3d\xa9\x47\x23\xb5\x8b\xa1\xae\x5d\x12\x8a\x54\x9d\x0a\x5d\xed\x47\x92\xe9\x67\x65\xd1\xf6\x4a\x34\x81\x40\xf3\x5d\x52\x52\x5f\x40\x5c\x91\x83\x67\x30\xf4\xb7\xf1\x16\x1c\x9a\x2a\x8e\x5c\x34\xd5\xc6\xc4\x12\xa7\x36\xb3\x75\x36\x25\x67\x50\xb5\xe9\xa4\xf6\x7d\xc3\x5f\xcd\x4a\xde\x95\x5e\x03\x86\x1d\x15\xf6\xd6\xe1\x35\x15\x18\x63\x09\xe9\x94\x46\xd8\xea\x3e\xf4\xf3\x5e\x55\xfd\x30\x46\x0c\xfd\xe4\xf7\xf7\x47\x3f\xc2\xd5\xd7\x00\x35\x60\xad\x0f\xfd\xc8\x2d\x95\x7e\x7c\xa3\x1f\x57\xa3\x1f\xff\x08\xfd\xf8\x0a\x38\x15\xb6\x45\x3f\xb8\x65\xa2\x9f\x85\x4e\xfd\xc1\xd9\xfb\x67\xe5\x2d\xf5\xcf\x32\xf5\x00\xf9\x50\x5e\xe2\x7f\xd8\x4e\x0e\x77\xfa\x88\x18\xa0\xd3\x07\x8b\xe3\x02\x7d\xe8\x83\x4b\x80\x8f\x16\x7c\x96\x71\x43\xd8\xce\xe2\x8d\x3e\x3a\x06\x7d\x74\xe2\xdb\x31\x56\x3e\x68\xf2\x47\xe7\xcc\xf0\xad\xf4\x31\x11\xfa\x98\x1e\x40\x2c\x5c\x1f\xf3\x9b\x2a\x6f\xf8\xa4\x02\x3d\xa8\x72\x29\x92\xe4\xa1\xca\x1f\xa1\xca\xad\x53\x65\x3b\x17\x7c\xab\x72\x9f\x8e\x78\x64\x64\x26\xeb\x54\xa3\x8a\x73\x1f\xd0\xb0\xf1\xae\xfc\x65\xaa\x0c\xf1\x37\xf2\xee\xb1\x74\x52\xe5\x27\xf9\xff\x1e\xaa\xc2\x59\x8d\xf0\x01\x08\x03\x7e\x85\xaa\xec\x39\x7f\x57\x39\x26\x55\x39\xe9\x75\xf0\x2a\xa7\x58\xa1\x2a\x3a\xde\xdc\xe6\x28\x91\x49\x76\xce\x0b\x64\x50\x15\xef\x1c\x48\x1b\x23\xf3\x67\xea\x27\x5f\xb4\x4e\x39\xa8\xea\x26\x31\x1f\xd0\x60\xa8\xa9\x7b\x8e\x90\x0a\xa3\x54\x2c\x13\xef\x1a\x5f\x15\x0d\xd7\xb6\x01\xa0\x38\x4e\x19\x55\x21\x53\xff\xc3\x02\x9f\xfb\xdc\xaa\x79\xd5\x85\x33\x27\x55\xcd\xe9\xad\x3a\x27\x18\x55\xdf\x72\xaa\xac\xbe\x43\x7f\xd8\xbb\xfa\xa9\x3b\x55\x37\xa9\x0f\xc8\x49\xd5\x61\x4b\x87\x9a\x48\x59\x28\xbb\x0c\x96\xc5\xd0\xac\xfe\xa0\xe8\xda\x3f\x0f\xd5\x75\xe6\xbe\xbb\xae\x3c\x30\xd7\x65\x9c\xb8\x5f\x54\xd7\x2f\x46\x52\x7d\x42\xf7\x41\x0d\xcf\x4d\x26\xd4\xb8\x50\x63\x54\xd8\xf8\x34\x99\xd4\x58\x91\xa2\x95\xf2\x6c\xd6\xf8\xc7\x83\x1a\x7a\xb8\x71\x6b\x8c\x64\xa4\x1a\x6a\xc1\xf1\x99\x67\xd2\xd3\x81\xb9\xe7\x6e\x9c\x2f\x27\x8d\x7b\x45\x56\x6c\x2f\xd9\x2f\xe0\x99\x32\xe3\xad\x3a\x3e\x59\x61\x84\xbe\xd2\xc6\x07\x00\xe5\xd2\x26\x2d\xaf\x0c\x31\xf0\x43\x53\xe0\xcc\x58\xe8\x2f\xc8\xcb\xf1\xab\x6d\x35\x6a\x58\xf7\x9b\x70\xf1\x1b\xc4\x00\xe9\x01\x4d\x78\x52\x13\xec\xce\xd1\x44\x29\x90\x23\x45\x99\x9a\x54\x9c\x84\x9a\x54\x30\xa4\xb5\x9a\x34\x0c\xe9\x26\x96\x5b\xca\x26\xb6\xa8\x49\xec\x48\x82\x1f\xb7\x9c\xa0\x5a\xde\x07\x36\x19\x17\x60\xa4\xe6\x32\x53\xee\xbc\xbc\x50\xd3\x52\xd0\x62\x2d\x96\x4e\xd2\xb4\x7e\x00\xe9\x17\x4d\x1b\xf4\xc0\x88\x6c\x6a\xaf\xee\x6a\xb0\xb0\xda\x82\x25\x35\x78\xcf\xd2\xb9\xaa\x34\x1d\xe8\xf2\x3c\x83\x37\x1d\x33\xbb\x01\xcd\xd7\x5f\x81\x84\xdf\x1c\x04\xcd\x37\x45\x19\x2f\x52\x81\x58\x02\x9a\x37\x7c\x62\x93\x9a\x9b\x4e\x08\x7b\xcf\xd7\xcd\xed\xb5\xae\x1b\xec\xe9\xd0\x2a\xef\xc4\x9a\xa7\xac\x30\xb5\x93\x9a\x8f\xff\x96\x4e\xa7\xf6\xde\x8d\xff\x3b\x21\x36\x7f\x25\x2d\xcb\xeb\xa0\x96\xef\x03\x0d\x5e\xd8\xfc\xab\x42\x0d\x57\x52\x6d\x1d\x07\x32\x17\x94\x5a\x75\x6a\xaf\x0f\xb5\x35\xb2\x61\x6b\x08\x1a\xbd\xc6\x95\x77\xef\x6d\x0d\x18\x64\x8d\x49\x6d\xe5\x13\x66\x7b\x86\xd4\x03\x24\xdd\xbd\x3d\xf3\x22\x63\x0c\x1e\xe3\x06\xe8\x1f\x35\xb2\xf7\xd5\xcb\x78\xcc\x87\x8c\x53\x39\x7b\x4f\x17\x26\x1c\x64\xb2\x7f\xc8\x44\x0a\x99\x9c\x3c\xb3\xb8\x9c\x79\x23\x69\xff\x0e\xd5\x26\xfd\x92\x9b\x4c\x22\x33\x07\x72\xf2\xcb\x37\x33\x93\x35\x61\x15\xcb\x03\x87\xc9\x3d\xc8\xe4\x77\x92\xe9\x2e\xf4\xf6\xac\x39\x5c\xdb\x5c\x07\xb0\xa9\x09\xa8\x97\x4c\x0c\x8c\x15\xf3\x80\xf6\x9e\x0c\x93\x5f\xf6\x99\x70\xc1\xca\xe6\x33\xc5\x39\xa6\x1e\x4c\xb3\xb6\xf6\x9a\xea\xbf\xe3\xd7\xf2\xca\xd1\xd6\x24\xe7\x0f\xf9\x26\x0f\xf9\x96\xeb\xa2\x6f\x55\x4f\x21\xdf\xc6\x8e\x36\xfb\x36\xb2\x19\xbe\x65\xbf\xf8\xf6\xcd\xeb\x3b\xdf\xf7\x15\xe4\xbb\xb0\x91\xef\xd3\x21\xd3\x8b\x07\xf9\x71\xe0\x93\x0c\x41\x05\x3f\xa6\x18\xb9\x56\xf2\x0f\x3f\xe4\xb5\x90\xe7\x8c\xe5\xf5\x69\x5d\x77\xf2\xa6\x93\xdc\xc0\x6a\xf8\x60\x70\xb7\xbc\x87\x73\xab\x0f\x79\x47\xf9\x2e\xc1\xe4\x5d\xdf\xec\x9e\x5b\x08\xef\xd9\x3b\xfe\xbe\x5d\x7a\x6c\x10\x14\x7b\x6e\x05\xdf\x5b\x25\x8f\xa2\x86\x89\xd6\xe3\x84\xa6\xa1\x62\xe0\xd1\x33\xcf\x6d\x1e\xb8\xd7\x20\x1f\x33\x6f\x0a\x5e\x87\xf4\x35\x73\x77\xe1\x6b\xbe\xb1\x0e\x2b\xad\x99\xab\xbe\xe3\xc0\xe8\x5f\x68\x84\xde\xf3\xdb\xf0\x21\xf4\xfb\x9c\x48\x4d\xe7\x22\xff\x43\x5b\x72\xbb\xd3\x39\x47\x6d\xc7\x70\xce\xcb\xba\xce\x95\x77\x84\x6b\x23\x1c\xc2\x19\x28\x15\xa8\x3b\x30\xb5\xe8\xdc\x13\x83\x0b\x78\x03\x7a\x77\x8e\x0f\x20\x3c\xc3\xf3\xa1\xce\x63\x50\xcf\xd9\xac\x63\x12\xeb\x3c\xf5\xe5\xcc\x1b\xeb\x9e\x93\x99\x51\xcf\x57\x90\xce\x5f\xc0\x93\x63\xb7\x4b\x2a\x21\x0c\x1f\xc8\x07\xe5\x2e\x3c\x38\x73\xaa\xee\x6c\x04\xb3\x77\x31\xc6\xa9\xbe\x8b\xed\x5a\xa9\x8b\x77\xb4\x45\xf2\xd6\xbb\x4b\x1c\x82\x16\x4a\xa0\x0b\xbb\xc4\x70\x14\x99\xd4\xaf\x6c\xfe\xe5\xd3\xa9\x5f\xc1\xa8\xfc\x7a\x86\x62\x25\xea\xca\xe6\xd4\x75\xcf\xd6\xea\xbb\xda\x76\x15\x68\xa3\x27\x3e\x81\x14\xad\x60\xad\x68\x29\xd6\xbe\xae\x6e\x82\x3a\xb5\x83\x6f\x4c\xb4\x4e\xb3\xdd\xfa\xf7\xc7\xd4\x5f\x9b\x56\x36\x54\x5f\xdf\x9b\xbb\x9e\x3b\xab\xbc\x56\xec\x55\x52\x8b\x9a\x57\x63\x1d\x6b\x1a\xf0\x04\xc0\x79\xba\x4b\x03\x4c\xea\xae\x30\x82\x57\x98\xc4\xd1\x5a\xc7\x2a\x4d\xdd\xad\x00\x1e\xea\x8e\xca\xbd\xaf\x97\x25\xd2\x09\xf3\x02\xf7\x5f\x60\xe8\x96\xe5\x06\xe4\x4c\x86\x11\xfe\x2d\xb1\x1d\x5e\x03\x7b\xfa\x5d\xd0\x9a\x3c\xb2\xf7\xe0\x3d\x47\x6b\x0f\x56\x28\x19\x92\xbf\xb0\xe8\x21\x47\x66\x0b\xfa\x1f\x74\x64\xcf\x85\x4c\x88\x08\xf9\xbe\xd1\xb7\xa8\x96\xc4\x06\x6f\xef\xa1\x6f\x8e\x87\x26\xab\x66\xcf\x84\x7e\xd3\x20\xa1\x7f\x40\x4c\x32\x0d\x34\x6f\x9a\x7a\x78\x59\x29\xc9\x0f\xf4\x66\x78\x5e\x13\xf7\xf0\x9c\x16\x7a\x78\xf3\x2c\xec\x7e\x00\xfb\xdb\x90\xf0\x91\xbe\x10\x3e\xff\xb1\xad\x02\xcc\x5b\xdb\xbe\xb6\x8a\x7e\x58\xa5\x60\x52\xea\x0b\x9d\xba\x6a\xa7\x77\xcb\xdf\x57\xcb\x29\xb7\xe7\x36\xa2\xaf\x0e\x17\x5b\x38\xd4\xf5\x15\xef\x0b\x5a\x5f\x6f\x0b\x56\xe4\x88\xef\x39\x6d\xf5\x35\xc0\x3e\xa9\xaf\xbf\x3f\x58\xfa\x09\x6e\x5a\x08\xd7\x76\xe0\xfe\x6f\xb1\xcd\xd5\x40\x63\x4a\xd0\x7f\x4b\x46\xf6\xcd\x7f\x0b\x3b\x58\x2c\x3f\x80\x3f\xfa\x6f\xa1\x49\xc1\x1b\x66\x8e\xe0\x7d\x77\x37\xca\xb3\x6f\xbe\xbb\x04\x17\x0e\xa0\x3a\x05\x6b\xa5\x3c\x10\xbf\x9d\x14\x8c\x9d\x77\x70\xeb\x14\x0c\xf5\x83\xad\x78\xa3\x77\x2e\x0a\xee\x5a\x28\x38\x10\xcc\x2a\x72\x50\x07\x7f\x05\x02\x6e\x0a\xfe\xf3\xa0\xf7\x57\x28\xf9\xd2\x18\xc2\xe8\xa3\x90\x4d\x10\x79\x5f\x82\x43\xf2\x3d\x36\x64\x17\xfd\x0a\xa8\xf6\x24\x1e\x99\x99\x2f\x23\x21\xd9\x6f\x21\x47\x2e\x42\x21\x79\xf8\x09\x39\x16\xd4\x94\x53\x53\xec\x19\x82\xbc\x33\x9d\x36\xe4\xe7\x65\xad\xfc\x0b\xcc\xb1\x11\x52\x55\x0e\xca\xdf\x2e\x84\xe4\x1e\x2d\xe4\xdf\x06\x26\x24\x77\x16\x21\xb9\x22\x87\x58\xc9\x44\x93\x9b\x72\x66\x0a\xc9\x59\x1a\x9e\xaa\xc8\xe8\xc2\x48\x7c\x47\x65\x08\x06\x09\xe1\x26\x48\x03\xd1\xb1\xaf\x24\x92\xa7\xd4\x90\xa1\x03\xd9\xc3\x57\x24\xfb\xe8\x6e\xa9\xd0\x58\x15\x19\xf3\x2d\x36\xe3\x95\x3a\x57\xa0\xaa\x65\x6f\xcb\xbe\xaf\xf9\xbe\x9a\xca\xe4\xdd\x54\x5c\xcf\xbc\x1a\x85\x6e\xf8\x36\xb0\x29\x24\x63\x92\xcf\x81\xf2\x3e\xea\x87\x1e\xa8\x3f\x97\x5c\x2c\x09\x85\xf2\x7a\x3e\xd4\x11\xcf\xdf\xd7\xe4\x1b\x7f\x68\xee\x1f\x22\x6f\x0b\x23\x5f\x78\xc2\xb9\x00\xa0\xb8\x6f\x9e\xb8\x32\x92\xa7\xb6\xf0\x96\x17\x39\x39\x58\xc2\xfd\xa3\x19\x6e\x14\x8e\x86\x79\x1e\x8f\x23\x6f\xa9\xdf\x1f\xe0\x84\xaf\x4c\xc1\x86\x3b\xd6\x96\x36\x5f\x50\x74\x9d\x14\x0b\x8a\x2c\xc3\x77\xf3\x43\xb1\x82\x2b\x0d\x2e\x34\xde\xb5\x65\x70\xc9\xd3\xf7\xe0\x03\x11\x45\x66\xcd\xec\xda\xdc\x40\x5e\x9c\x00\xd4\x33\xb8\x25\xf4\x2c\x6c\xe0\x9c\x3a\x8e\x07\xd4\xc7\xa5\x34\x18\x2e\x35\x78\xe5\x2e\x74\xf0\x17\xf0\xd0\xd8\x19\x25\x76\x36\x40\x64\x30\xb7\xcb\x63\x17\x43\xec\x92\x96\xc4\xbd\xd2\xd8\x55\x60\x83\xb1\xeb\x18\x1e\x83\xc6\xee\x91\x0f\xe7\x63\xf7\x35\x69\xec\xc1\x1d\x28\x82\xa4\xd0\x9e\x69\x6b\xa3\x21\x8c\x0f\x1b\xfb\xf1\x0e\x8b\x21\x3c\x69\xc8\xee\xd0\x55\xf6\x90\x8c\xe5\x98\x1e\xb2\xbf\x73\xc4\x10\x41\xa6\x7c\x68\xc8\x99\x6b\xdc\xc8\x17\x0d\x90\x4a\x03\x1e\xcc\x41\x43\xf2\xcc\x3b\xc4\x86\x00\xe7\xab\xa3\x84\xca\x00\xc9\xa7\xb2\x21\x63\xbc\xb2\xf3\x80\x35\x64\xae\x4e\x43\x30\x78\xc7\x95\x67\x81\x71\xf1\x31\x81\x79\x89\x33\xae\x34\xc6\x05\x05\xae\xac\xed\x92\xc0\x23\xf9\xb8\xf2\xf6\x78\x5c\x9a\xdc\x6a\xe0\xd2\x0e\x80\x17\x0d\xdc\x0e\x01\x91\xec\x0e\x16\x47\xa6\x47\x06\x57\x3e\xcb\x0c\x5c\xf1\xd0\xb8\x42\x1b\xf2\xe0\x11\xe3\x5a\x07\x5c\x77\x5c\x0f\x61\xb1\x81\xd7\x0e\x4c\x6d\xb9\x47\x19\x2a\xe8\xb4\x74\xeb\x7c\x2b\x1c\x5a\xd3\x1c\x38\x2f\x0c\xc5\xbc\x35\xb4\x66\xfd\xda\xb4\x72\xd2\x74\x85\x7c\xdd\x1e\xaf\xb4\x40\x63\x35\x77\x33\x03\xfe\x3f\x91\xf3\x4b\x03\x6b\xc7\xf8\x64\x34\xef\x06\x68\x7c\x14\x1f\x5a\x8d\xc9\x7c\x7c\x14\xda\x7f\x30\xd7\x0f\x1c\xee\x47\xe5\x46\xa3\x8a\x74\x60\xce\x1c\x23\x57\xd4\x91\x37\x9b\xe3\x3d\xf1\x8e\xaa\xe0\xf2\x93\x0d\x04\x09\x30\x6c\xde\x63\x8f\x86\x59\x70\x34\x86\xe0\xa6\xd0\xb4\xf9\x27\xd1\xe7\x45\xc3\x30\x59\x0f\x63\x24\x19\x7c\xca\xd2\xf6\x06\x01\x8e\xb8\xef\x3b\x6a\xf5\x7c\xc9\x1e\x69\x73\x2f\x4c\xc3\xd1\x2b\xb9\xd2\x0f\xaf\x45\x93\xa7\x6a\x01\xbe\x3f\x18\x19\x79\x5f\x33\xbc\x89\x1b\x28\x2c\xe3\x99\x1e\xf1\x00\x51\x7e\x41\x26\x06\xf1\x70\xb8\xc9\x3b\x8b\x0d\x5f\x50\xad\x33\xc2\xb9\x7e\x8f\xce\x79\xff\x34\x3a\xdf\x46\xa3\x0b\x7f\x80\xaf\x4e\x3d\x9d\xb7\xa7\xef\xe4\x93\xf0\xe8\x97\x64\xa1\xb4\x54\xd7\x34\x5b\xd7\x4f\x46\x50\x5c\x43\x27\x8d\x5e\x13\x1d\x63\x1e\xb3\xa6\x83\x2b\x35\xcc\x39\x77\x74\x58\xb2\x07\xc6\x6e\x0f\x61\xc8\xcd\x39\x6e\xf4\x87\x06\xd6\x47\x01\xc9\xdf\x3b\xe1\x58\x16\x21\x95\xc6\x7b\x9e\x18\x93\x8b\xae\x06\x0a\x6b\xce\x9c\x09\x26\x6b\x0c\x10\xf8\xe2\xcc\xa9\x63\x66\xb7\xe4\x8c\x36\x26\x2a\x9a\xd9\xb2\x29\x29\x4a\x20\x00\x5d\x3f\x25\xc4\x69\xcc\x74\xd3\x99\xee\x32\x53\x93\x99\xfd\x31\x1d\x17\x07\xa0\x06\x39\x39\x81\xe4\x35\xea\x98\xe9\xfe\x33\x78\xca\x89\x68\x88\xa0\xbe\x48\x5b\xcc\xc8\x3e\x99\xb1\xf2\x76\x7d\xcc\x55\xd2\xd5\xe7\x4a\x9d\xdf\x7b\xd0\x31\x1f\xe0\x7a\x0f\x38\x63\x6d\x4d\x93\x60\x32\x1d\xeb\xfd\xf1\xec\xc0\xf9\x72\xac\xbc\xd9\x1d\x2b\x0f\x30\x63\x9d\x9c\x78\xca\x00\x7f\x16\xca\x6b\xe8\xb1\xc0\x64\x86\xe2\x36\xa0\xce\xea\x99\xdc\x71\x20\x1d\xab\x47\xce\x83\x2b\x12\xf2\x7d\x6c\xac\x38\x13\x7b\xe8\xc8\xc0\x3b\xf1\x8f\x15\x5f\x41\x99\xf7\x57\x94\x63\x8d\x3c\x67\x8d\xfb\xdf\xcc\x72\xa7\xa5\x6f\x4e\x88\x46\xe3\x16\x28\x75\xa7\x11\xee\x9c\x56\x6e\x8c\x9c\x3b\x6d\x79\xe7\x96\x78\xdc\x1e\x85\xc6\xd3\x36\x58\xf1\x69\x7d\x7a\xa3\xf1\x04\xbc\xf3\xc9\x2e\x79\x7b\x18\x3f\xc0\x4c\x72\x52\xfe\x6e\x60\x72\xce\x13\x93\xeb\x87\x26\x1b\xa0\x23\x3b\x9f\x82\x26\x0f\x24\x8c\x89\x94\x39\xdd\x69\xf2\xaf\xd2\xcc\x5f\x2f\x4e\xe1\x46\x13\xde\x3b\xc5\xf0\x31\xe4\x88\x99\x0e\x10\x84\x03\x0c\x03\xa1\xdf\x49\xf3\x4a\xf1\x17\x23\x28\x4d\x80\x06\x40\x8f\xcf\xf4\xfc\x79\x09\x82\x68\xd6\xbc\x20\xe6\xf2\xfc\x45\xd7\xbc\x42\x90\x9b\xaf\xed\xf3\x0a\xbf\x69\xe2\xbe\x1b\x98\x93\xcb\x7c\x7f\xe3\x32\xb5\x08\x4d\x3d\x33\xa5\x22\x9e\x3b\x99\xa9\xa8\x4d\xed\xa1\xa9\x9d\xa6\x86\x14\x9a\xef\xe5\xeb\xd4\x09\x6b\xe4\xa2\x3e\x7d\xc3\x76\x90\xa6\x17\x7e\x80\xa5\xa2\xb0\x23\xff\x14\x68\x48\xd3\xb5\xa2\x9e\xfc\xd9\x1f\xdc\x77\x82\xbd\xbd\x3f\x42\x4c\x37\x9e\x6e\xe7\x4a\x92\x53\x5c\x3a\xf5\xcc\x29\x6b\x7a\xc7\xa7\x3b\xb0\x67\xad\x58\xed\xa6\x87\x71\x81\x98\x98\x79\xf8\x9f\x3e\x06\x4d\x9f\x8c\x82\x2b\x34\x35\xcb\x2d\xce\xcc\xa3\xc3\xf4\x1b\x75\x3f\x34\x73\xc3\x3a\xf3\xb4\x38\xf1\xb4\x04\xd1\x91\xd7\x6f\x33\x77\xad\x33\xd8\x06\xbc\x7a\x06\x77\xc0\xb8\x80\x5f\xa9\x94\xbf\x0b\x7d\x37\x57\x33\x6d\x1b\x62\x85\x66\x5e\x8d\xcd\xfc\xe9\xc9\xcc\x9f\x42\xce\xd0\x33\xcd\x19\xda\x00\x10\xe3\xfd\x42\xd9\xf7\x97\x14\x33\x56\xb2\xad\x0c\x56\x64\xac\xd6\x05\x62\xd7\x48\x44\xd3\xd1\xc3\x0b\x42\xd7\x7b\x60\xfa\x37\x48\xe7\x32\x06\x18\x34\x5a\x81\x0b\xa5\xdc\xe8\xcd\x15\xd9\x2d\xb7\x60\x2a\x9e\xb7\xd8\x7c\x68\xde\xba\x23\x82\x86\xdd\x69\x95\xdb\x69\x3e\x5d\x00\x79\xcc\x5c\x67\x7d\x68\xb5\x2d\xa4\x56\xa6\xf5\xfe\xd4\x60\xd9\xfb\x6b\xe0\x65\x7b\xc6\xde\x1f\xa6\xbc\x6e\xb3\xac\x38\x2d\xcb\x9f\xa8\x2f\xcb\x27\xd2\x65\xef\x13\xd3\x32\xcd\x3d\xf5\xb2\xfc\x69\xf6\x32\x9d\x80\xaf\xc4\x40\x0c\xd7\xd8\x46\xcb\xf2\x8e\x75\xd9\xd4\x4a\xcb\x56\xfe\x4e\x71\xd9\x57\x10\xed\x79\x9b\xb9\xfa\x99\x1d\xb4\x7a\x3e\x07\xad\xee\x46\x2b\x8f\xd2\xab\x0f\x99\xb4\x62\x63\xa3\x9c\x28\xde\x8d\xd7\x1a\xf9\x15\xc0\xb1\x2a\x48\x95\x31\xe8\x9f\xf0\xf9\xfe\x67\xe0\xcb\x3b\xdb\x04\x59\xab\xd1\x97\xe1\x73\x5f\xc6\x3a\xf6\x7d\x7f\x07\xff\x65\x98\xee\xcb\x86\x4f\xc7\x45\x5f\xee\x1e\xf4\xe5\xf7\xa7\x58\x5f\x1e\x28\xbd\xea\xa4\xaf\x5c\x0a\xd3\x7c\x61\x6c\x44\xad\x80\x31\x7f\x14\x89\x54\x83\x68\x89\x0d\x80\xcb\x40\x98\x00\x7d\xf8\x95\x40\x64\x0c\xa9\xf4\x95\x29\x81\xba\x34\x6d\xfe\xd5\x2d\x5f\x10\xbf\xba\xbf\xb5\xe9\x9e\xf3\x3a\x0e\x88\x4e\xb9\x6b\xff\x6a\xad\x68\xee\x57\x6d\xbe\xd4\xab\x42\x40\xe4\xbe\xfb\xab\x91\xe5\x06\x03\x14\xa2\xc6\x9b\x3e\x13\xbf\x68\x69\xde\x72\x7f\x5d\x77\xa1\xfc\xf1\xf7\xd7\xeb\xce\xe6\xa0\xab\x21\x6d\x02\x1e\x88\xbf\xff\x81\x1b\xdd\xac\x93\x6e\xcc\x77\x98\x6f\x01\xb6\x90\x60\x80\x38\x38\xc0\x18\x0d\x10\xea\x41\x37\x86\xcd\xcd\xa3\x03\x26\x32\xa7\x20\xf5\x8b\xe0\x43\xf7\xfb\xae\x78\x0b\xa3\x6b\x73\xa6\xbe\x85\x61\x94\x5b\xde\x13\xe0\x2d\x1b\xdd\xf2\x1e\x87\x6f\x91\x8f\x58\xa1\x5b\x34\x80\x35\xdf\xbc\x6e\x19\x93\x6e\x99\x74\xe7\xeb\xd5\x8d\x39\xf2\xbe\xe4\x45\x48\xba\xc4\x00\x50\x0d\x5b\xc9\xfb\xd2\x3c\x81\xdf\x5a\x04\x80\xfa\xf5\x40\xb0\x16\x40\x25\x8c\x92\x5b\x0d\xdb\xd6\x5b\x0d\x39\xa8\x5c\xed\x03\xb0\x2c\x6a\xd9\x0c\x85\x4c\x1d\xc5\x1b\x48\x86\x21\xec\xfd\x8d\xc2\xed\xf5\xa0\xdb\x1b\x1b\xdd\x9e\x23\xe6\x76\x2f\x80\x4a\xb9\xde\xe4\xe5\xe6\xed\x51\x33\x18\x0f\x10\xca\x04\x77\xba\x43\xf6\x0f\x70\x4c\xb4\x29\x27\xb2\x3b\x14\x16\xcc\x05\xfc\xe1\x28\xf4\x08\x07\x3d\x92\xeb\xde\xe3\x0b\x1f\xb2\x72\x23\xf5\x27\x5b\x30\xfd\x49\x38\xe5\xd5\xda\x9f\x3b\xfd\x3f\x00\x96\xd2\xdb\xc1\x3c\x33\x00\x00")
+)
+
+// englishWords decompresses the embedded wordlist and checks it
+// against the digest of the official list.
+func englishWords() ([]string, error) {
+	gz, err := gzip.NewReader(bytes.NewBuffer(englishWordsBytes))
+	if err != nil {
+		return nil, fmt.Errorf("Read %q: %v", "english.txt", err)
+	}
+
+	var buf bytes.Buffer
+	_, err = io.Copy(&buf, gz)
+	gz.Close()
+
+	if err != nil {
+		return nil, fmt.Errorf("Read %q: %v", "english.txt", err)
+	}
+
+	sum := sha256.Sum256(buf.Bytes())
+	if hex.EncodeToString(sum[:]) != englishSHA256 {
+		return nil, fmt.Errorf("Read %q: checksum mismatch", "english.txt")
+	}
+	return strings.Split(strings.TrimSpace(buf.String()), "\n"), nil
+}
@@ -0,0 +1,146 @@
+// Copyright (C) 2014-15 Michail Kargakis
+// This source code is subject to the terms
+// of the MIT License
+
+// Package cashaddr implements the CashAddr encoding of Bitcoin Cash
+// addresses.
+package cashaddr
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/kargakis/cryptowallet/bech32"
+)
+
+const charset = "qpzry9x8gf2tvdw0s3jn54khce6mua7l"
+
+// checksumLength is the number of characters of the checksum.
+const checksumLength = 8
+
+// Address types.
+const (
+	P2PKH byte = 0
+	P2SH  byte = 1
+)
+
+var (
+	// ErrMixedCase is returned for addresses mixing upper and lower
+	// case characters.
+	ErrMixedCase = errors.New("cashaddr: mixed case")
+
+	// ErrChecksum is returned when an address fails checksum
+	// verification.
+	ErrChecksum = errors.New("cashaddr: invalid checksum")
+
+	// ErrInvalidChar is returned for addresses with characters
+	// outside the CashAddr character set.
+	ErrInvalidChar = errors.New("cashaddr: invalid character")
+
+	// ErrHashSize is returned for hashes of a size CashAddr cannot
+	// encode.
+	ErrHashSize = errors.New("cashaddr: invalid hash size")
+)
+
+// hashSizes are the hash sizes in bytes by their size code in the
+// version byte.
+var hashSizes = [8]int{20, 24, 28, 32, 40, 48, 56, 64}
+
+var generator = [5]uint64{0x98f2bc8e61, 0x79b76d99e2, 0xf33e5fb3c4, 0xae2eabe2a8, 0x1e4f43e470}
+
+func polymod(values []byte) uint64 {
+	c := uint64(1)
+	for _, v := range values {
+		top := c >> 35
+		c = (c&0x07ffffffff)<<5 ^ uint64(v)
+		for i := 0; i < 5; i++ {
+			if (top>>uint(i))&1 == 1 {
+				c ^= generator[i]
+			}
+		}
+	}
+	return c ^ 1
+}
+
+// prefixExpand returns the lower five bits of each character of
+// prefix followed by the separator.
+func prefixExpand(prefix string) []byte {
+	out := make([]byte, 0, len(prefix)+1)
+	for i := 0; i < len(prefix); i++ {
+		out = append(out, prefix[i]&31)
+	}
+	return append(out, 0)
+}
+
+func createChecksum(prefix string, data []byte) []byte {
+	values := append(prefixExpand(prefix), data...)
+	values = append(values, make([]byte, checksumLength)...)
+	mod := polymod(values)
+	checksum := make([]byte, checksumLength)
+	for i := range checksum {
+		checksum[i] = byte(mod>>uint(5*(checksumLength-1-i))) & 31
+	}
+	return checksum
+}
+
+// Encode returns the CashAddr address with the given prefix, such as
+// bitcoincash, of the hash of a public key or script.
+func Encode(prefix string, typ byte, hash []byte) (string, error) {
+	size := -1
+	for code, n := range hashSizes {
+		if n == len(hash) {
+			size = code
+		}
+	}
+	if size < 0 || typ > 15 {
+		return "", ErrHashSize
+	}
+	prefix = strings.ToLower(prefix)
+	data, err := bech32.ConvertBits(append([]byte{typ<<3 | byte(size)}, hash...), 8, 5, true)
+	if err != nil {
+		return "", err
+	}
+	data = append(data, createChecksum(prefix, data)...)
+	var b strings.Builder
+	b.WriteString(prefix)
+	b.WriteByte(':')
+	for _, d := range data {
+		b.WriteByte(charset[d])
+	}
+	return b.String(), nil
+}
+
+// Decode returns the prefix, type and hash of a CashAddr address.
+// Addresses without a prefix are checked against defaultPrefix.
+func Decode(addr, defaultPrefix string) (string, byte, []byte, error) {
+	if strings.ToLower(addr) != addr && strings.ToUpper(addr) != addr {
+		return "", 0, nil, ErrMixedCase
+	}
+	addr = strings.ToLower(addr)
+	prefix, payload := strings.ToLower(defaultPrefix), addr
+	if i := strings.LastIndexByte(addr, ':'); i >= 0 {
+		prefix, payload = addr[:i], addr[i+1:]
+	}
+	if len(payload) <= checksumLength {
+		return "", 0, nil, ErrChecksum
+	}
+	data := make([]byte, len(payload))
+	for i := 0; i < len(payload); i++ {
+		d := strings.IndexByte(charset, payload[i])
+		if d < 0 {
+			return "", 0, nil, ErrInvalidChar
+		}
+		data[i] = byte(d)
+	}
+	if polymod(append(prefixExpand(prefix), data...)) != 0 {
+		return "", 0, nil, ErrChecksum
+	}
+	decoded, err := bech32.ConvertBits(data[:len(data)-checksumLength], 5, 8, false)
+	if err != nil {
+		return "", 0, nil, err
+	}
+	if len(decoded) == 0 || decoded[0]&0x80 != 0 || len(decoded)-1 != hashSizes[decoded[0]&7] {
+		return "", 0, nil, ErrHashSize
+	}
+	return prefix, decoded[0] >> 3, decoded[1:], nil
+}
@@ -0,0 +1,97 @@
+// Copyright (C) 2014-15 Michail Kargakis
+// This source code is subject to the terms
+// of the MIT License
+
+package cashaddr
+
+import (
+	"bytes"
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/kargakis/cryptowallet/bech32"
+)
+
+// vectors are test vectors of the CashAddr specification.
+var vectors = []struct {
+	prefix  string
+	typ     byte
+	hash    string
+	address string
+}{
+	{"bitcoincash", P2PKH, "F5BF48B397DAE70BE82B3CCA4793F8EB2B6CDAC9", "bitcoincash:qr6m7j9njldwwzlg9v7v53unlr4jkmx6eylep8ekg2"},
+	{"bchtest", P2SH, "F5BF48B397DAE70BE82B3CCA4793F8EB2B6CDAC9", "bchtest:pr6m7j9njldwwzlg9v7v53unlr4jkmx6eyvwc0uz5t"},
+	{"pref", P2SH, "F5BF48B397DAE70BE82B3CCA4793F8EB2B6CDAC9", "pref:pr6m7j9njldwwzlg9v7v53unlr4jkmx6ey65nvtks5"},
+	{"prefix", 15, "F5BF48B397DAE70BE82B3CCA4793F8EB2B6CDAC9", "prefix:0r6m7j9njldwwzlg9v7v53unlr4jkmx6ey3qnjwsrf"},
+	{"bitcoincash", P2PKH, "7ADBF6C17084BC86C1706827B41A56F5CA32865925E946EA", "bitcoincash:q9adhakpwzztepkpwp5z0dq62m6u5v5xtyj7j3h2ws4mr9g0"},
+	{"bitcoincash", P2PKH, "3A84F9CF51AAE98A3BB3A78BF16A6183790B18719126325BFC0C075B", "bitcoincash:qgagf7w02x4wnz3mkwnchut2vxphjzccwxgjvvjmlsxqwkcw59jxxuz"},
+	{"bitcoincash", P2PKH, "3173EF6623C6B48FFD1A3DCC0CC6489B0A07BB47A37F47CFEF4FE69DE825C060", "bitcoincash:qvch8mmxy0rtfrlarg7ucrxxfzds5pamg73h7370aa87d80gyhqxq5nlegake"},
+}
+
+func TestEncode(t *testing.T) {
+	for _, v := range vectors {
+		hash, _ := hex.DecodeString(v.hash)
+		addr, err := Encode(v.prefix, v.typ, hash)
+		if err != nil {
+			t.Errorf("%s: %v", v.address, err)
+			continue
+		}
+		if addr != v.address {
+			t.Errorf("%s: encoded %s", v.address, addr)
+		}
+	}
+	for _, size := range []int{0, 19, 21, 33, 65} {
+		if _, err := Encode("bitcoincash", P2PKH, make([]byte, size)); err != ErrHashSize {
+			t.Errorf("%d byte hash: error %v, want %v", size, err, ErrHashSize)
+		}
+	}
+}
+
+func TestDecode(t *testing.T) {
+	for _, v := range vectors {
+		hash, _ := hex.DecodeString(v.hash)
+		payload := v.address[len(v.prefix)+1:]
+		for _, addr := range []string{v.address, strings.ToUpper(v.address), payload} {
+			prefix, typ, decoded, err := Decode(addr, v.prefix)
+			if err != nil {
+				t.Errorf("%s: %v", addr, err)
+				continue
+			}
+			if prefix != v.prefix || typ != v.typ || !bytes.Equal(decoded, hash) {
+				t.Errorf("%s: decoded %s, %d, %x", addr, prefix, typ, decoded)
+			}
+		}
+	}
+}
+
+func TestDecodeInvalid(t *testing.T) {
+	valid := vectors[0].address
+	// A version byte claiming a 24-byte hash before a 20-byte one.
+	data, _ := bech32.ConvertBits(append([]byte{P2PKH<<3 | 1}, make([]byte, 20)...), 8, 5, true)
+	data = append(data, createChecksum("bitcoincash", data)...)
+	var wrongSize strings.Builder
+	wrongSize.WriteString("bitcoincash:")
+	for _, d := range data {
+		wrongSize.WriteByte(charset[d])
+	}
+
+	tests := []struct {
+		addr, defaultPrefix string
+		err                 error
+	}{
+		{"bitcoincash:qr6m7j9njldwwzlg9v7v53unlr4jkmx6eyLEP8EKG2", "", ErrMixedCase},
+		{valid[:len(valid)-1] + "3", "", ErrChecksum},
+		{"bchtest" + valid[len("bitcoincash"):], "", ErrChecksum},
+		// The payload alone checked against another prefix.
+		{valid[len("bitcoincash:"):], "bchtest", ErrChecksum},
+		{"bitcoincash:qr6m7j9njldwwzlg9v7v53unlr4jkmx6eylep8ekgb", "", ErrInvalidChar},
+		{"bitcoincash:qqqqqqqq", "", ErrChecksum},
+		{wrongSize.String(), "", ErrHashSize},
+	}
+	for _, test := range tests {
+		if _, _, _, err := Decode(test.addr, test.defaultPrefix); err != test.err {
+			t.Errorf("%s: error %v, want %v", test.addr, err, test.err)
+		}
+	}
+}
@@ -0,0 +1,102 @@
+// Copyright (C) 2014-15 Michail Kargakis
+// This source code is subject to the terms
+// of the MIT License
+
+// Package descriptor computes and verifies the checksums of output
+// script descriptors as specified in BIP380.
+package descriptor
+
+import (
+	"errors"
+	"strings"
+)
+
+const (
+	inputCharset    = "0123456789()[],'/*abcdefgh@:$%{}IJKLMNOPQRSTUVWXYZ&+-.;<=>?!^_|~ijklmnopqrstuvwxyzABCDEFGH`#\"\\ "
+	checksumCharset = "qpzry9x8gf2tvdw0s3jn54khce6mua7l"
+	checksumLen     = 8
+)
+
+var (
+	// ErrInvalidChar is returned for descriptors with characters
+	// outside the descriptor character set.
+	ErrInvalidChar = errors.New("descriptor: invalid character")
+
+	// ErrChecksum is returned when a descriptor fails checksum
+	// verification.
+	ErrChecksum = errors.New("descriptor: invalid checksum")
+)
+
+var generator = [5]uint64{0xf5dee51989, 0xa9fdca3312, 0x1bab10e32d, 0x3706b1677a, 0x644d626ffd}
+
+func polymod(c uint64, v int) uint64 {
+	top := c >> 35
+	c = (c&0x7ffffffff)<<5 ^ uint64(v)
+	for i := 0; i < 5; i++ {
+		if (top>>uint(i))&1 == 1 {
+			c ^= generator[i]
+		}
+	}
+	return c
+}
+
+// Checksum returns the checksum of desc, which must not have one
+// already.
+func Checksum(desc string) (string, error) {
+	c := uint64(1)
+	// Each character adds its position within a group of 32 and,
+	// three characters at a time, the groups.
+	groups, count := 0, 0
+	for _, r := range desc {
+		pos := strings.IndexRune(inputCharset, r)
+		if pos < 0 {
+			return "", ErrInvalidChar
+		}
+		c = polymod(c, pos&31)
+		groups = groups*3 + pos>>5
+		if count++; count == 3 {
+			c = polymod(c, groups)
+			groups, count = 0, 0
+		}
+	}
+	if count > 0 {
+		c = polymod(c, groups)
+	}
+	for i := 0; i < checksumLen; i++ {
+		c = polymod(c, 0)
+	}
+	c ^= 1
+
+	checksum := make([]byte, checksumLen)
+	for i := range checksum {
+		checksum[i] = checksumCharset[(c>>uint(5*(checksumLen-1-i)))&31]
+	}
+	return string(checksum), nil
+}
+
+// Append returns desc followed by its checksum.
+func Append(desc string) (string, error) {
+	checksum, err := Checksum(desc)
+	if err != nil {
+		return "", err
+	}
+	return desc + "#" + checksum, nil
+}
+
+// Verify checks the checksum following the # of s. Descriptors
+// without a checksum are only checked for invalid characters.
+func Verify(s string) error {
+	i := strings.Index(s, "#")
+	if i < 0 {
+		_, err := Checksum(s)
+		return err
+	}
+	checksum, err := Checksum(s[:i])
+	if err != nil {
+		return err
+	}
+	if s[i+1:] != checksum {
+		return ErrChecksum
+	}
+	return nil
+}
@@ -0,0 +1,69 @@
+// Copyright (C) 2014-15 Michail Kargakis
+// This source code is subject to the terms
+// of the MIT License
+
+package descriptor
+
+import "testing"
+
+// valid are the descriptors of the BIP380 test vectors with checksums.
+var valid = []struct {
+	desc, checksum string
+}{
+	{"raw(deadbeef)", "89f8spxm"},
+	{"sh(multi(2,[00000000/111'/222]xprvA1RpRA33e1JQ7ifknakTFpgNXPmW2YvmhqLQYMmrj4xJXXWYpDPS3xz7iAxn8L39njGVyuoseXzU6rcxFLJ8HFsTjSyQbLYnMpCqE2VbFWc,xprv9uPDJpEQgRQfDcW7BkF7eTya6RPxXeJCqCJGHuCJ4GiRVLzkTXBAJMu2qaMWPrS7AANYqdq6vcBcBUdJCVVFceUvJFjaPdGZ2y9WACViL4L/0))", "ggrsrxfy"},
+	{"sh(multi(2,[00000000/111'/222]xpub6ERApfZwUNrhLCkDtcHTcxd75RbzS1ed54G1LkBUHQVHQKqhMkhgbmJbZRkrgZw4koxb5JaHWkY4ALHY2grBGRjaDMzQLcgJvLJuZZvRcEL,xpub68NZiKmJWnxxS6aaHmn81bvJeTESw724CRDs6HbuccFQN9Ku14VQrADWgqbhhTHBaohPX4CjNLf9fq9MYo6oDaPPLPxSb7gwQN3ih19Zm4Y/0))", "tjg09x5t"},
+}
+
+func TestChecksum(t *testing.T) {
+	for _, v := range valid {
+		checksum, err := Checksum(v.desc)
+		if err != nil {
+			t.Fatalf("%s: %v", v.desc, err)
+		}
+		if checksum != v.checksum {
+			t.Errorf("%s: checksum %s, want %s", v.desc, checksum, v.checksum)
+		}
+		appended, err := Append(v.desc)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if appended != v.desc+"#"+v.checksum {
+			t.Errorf("%s: appended %s", v.desc, appended)
+		}
+		if err := Verify(appended); err != nil {
+			t.Errorf("%s: %v", appended, err)
+		}
+		// Descriptors without a checksum are valid.
+		if err := Verify(v.desc); err != nil {
+			t.Errorf("%s: %v", v.desc, err)
+		}
+	}
+}
+
+func TestVerifyInvalid(t *testing.T) {
+	tests := []struct {
+		desc string
+		err  error
+	}{
+		// Missing checksum after the #.
+		{"raw(deadbeef)#", ErrChecksum},
+		// Too long checksum.
+		{"raw(deadbeef)#89f8spxmx", ErrChecksum},
+		// Too short checksum.
+		{"raw(deadbeef)#89f8spx", ErrChecksum},
+		// Error in payload.
+		{"raw(deedbeef)#89f8spxm", ErrChecksum},
+		// Error in checksum.
+		{"raw(deedbeef)#j9f8spxm", ErrChecksum},
+		// Invalid characters in checksum.
+		{"raw(deadbeef)#8 9f8spx", ErrChecksum},
+		// Invalid characters in payload.
+		{"raw(Ü)#00000000", ErrInvalidChar},
+	}
+	for _, test := range tests {
+		if err := Verify(test.desc); err != test.err {
+			t.Errorf("%s: %v, want %v", test.desc, err, test.err)
+		}
+	}
+}
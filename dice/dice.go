@@ -0,0 +1,127 @@
+// Copyright (C) 2014-15 Michail Kargakis
+// This source code is subject to the terms
+// of the MIT License
+
+// Package dice turns physical dice rolls or coin flips into key
+// entropy that can be re-derived and audited by hand.
+//
+// The rolls are written out in a canonical form, the SHA-256 digest
+// of which is the entropy:
+//
+//	coin flips        one 1 (heads) or 0 (tails) per flip
+//	3 to 9 sides      one digit per roll
+//	10 or more sides  rolls separated by single spaces
+//
+// so that, for example, the entropy of the six-sided die rolls
+// 1, 6, 3 is the output of
+//
+//	printf 163 | sha256sum
+//
+// The 0 face of a ten-sided die is read as 10.
+package dice
+
+import (
+	"crypto/sha256"
+	"errors"
+	"math"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+var (
+	// ErrSides is returned for dice with fewer than two sides.
+	ErrSides = errors.New("dice: a die needs at least 2 sides")
+
+	// ErrRoll is returned for rolls that are not a face of the die.
+	ErrRoll = errors.New("dice: invalid roll")
+)
+
+// Parse reads the rolls of a die with the given number of sides from
+// s. Rolls of dice with up to 9 sides may be written without
+// separators. Coin flips are written as h and t or as 1 and 0, and
+// ten-sided dice may show 0 for 10. Whitespace and commas between
+// rolls are ignored.
+func Parse(s string, sides int) ([]int, error) {
+	if sides < 2 {
+		return nil, ErrSides
+	}
+	separator := func(r rune) bool { return unicode.IsSpace(r) || r == ',' }
+	var rolls []int
+	if sides > 9 {
+		for _, field := range strings.FieldsFunc(s, separator) {
+			roll, err := strconv.Atoi(field)
+			if sides == 10 && field == "0" {
+				roll = 10
+			}
+			if err != nil || roll < 1 || roll > sides {
+				return nil, ErrRoll
+			}
+			rolls = append(rolls, roll)
+		}
+		return rolls, nil
+	}
+	for _, r := range s {
+		if separator(r) {
+			continue
+		}
+		roll, ok := face(r, sides)
+		if !ok {
+			return nil, ErrRoll
+		}
+		rolls = append(rolls, roll)
+	}
+	return rolls, nil
+}
+
+// face returns the roll written as r. Coin flips are 1 for heads and
+// 0 for tails.
+func face(r rune, sides int) (int, bool) {
+	if sides == 2 {
+		switch unicode.ToLower(r) {
+		case 'h', '1':
+			return 1, true
+		case 't', '0':
+			return 0, true
+		}
+		return 0, false
+	}
+	roll := int(r - '0')
+	return roll, roll >= 1 && roll <= sides
+}
+
+// Bits returns the entropy in bits of n rolls of a fair die with the
+// given number of sides.
+func Bits(n, sides int) float64 {
+	return float64(n) * math.Log2(float64(sides))
+}
+
+// Rolls returns the number of rolls of a fair die with the given
+// number of sides needed for bits of entropy.
+func Rolls(bits, sides int) int {
+	return int(math.Ceil(float64(bits) / math.Log2(float64(sides))))
+}
+
+// Canonical returns the rolls in the canonical form that is hashed
+// into entropy.
+func Canonical(rolls []int, sides int) string {
+	parts := make([]string, len(rolls))
+	for i, roll := range rolls {
+		parts[i] = strconv.Itoa(roll)
+	}
+	if sides > 9 {
+		return strings.Join(parts, " ")
+	}
+	return strings.Join(parts, "")
+}
+
+// Entropy returns the SHA-256 digest of the canonical form of rolls
+// followed by extra, which mixes in other entropy such as system
+// randomness. Extra must be recorded for the entropy to be
+// re-derived.
+func Entropy(rolls []int, sides int, extra []byte) []byte {
+	h := sha256.New()
+	h.Write([]byte(Canonical(rolls, sides)))
+	h.Write(extra)
+	return h.Sum(nil)
+}
@@ -0,0 +1,94 @@
+// Copyright (C) 2014-15 Michail Kargakis
+// This source code is subject to the terms
+// of the MIT License
+
+package dice
+
+import (
+	"encoding/hex"
+	"reflect"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		s     string
+		sides int
+		rolls []int
+		err   error
+	}{
+		{"163", 6, []int{1, 6, 3}, nil},
+		{"1 6,3\n", 6, []int{1, 6, 3}, nil},
+		{"hTtH", 2, []int{1, 0, 0, 1}, nil},
+		{"1001", 2, []int{1, 0, 0, 1}, nil},
+		{"10 3 12", 12, []int{10, 3, 12}, nil},
+		{"20,1\t7", 20, []int{20, 1, 7}, nil},
+		{"0 10 5", 10, []int{10, 10, 5}, nil},
+		{"", 6, nil, nil},
+		{"7", 6, nil, ErrRoll},
+		{"0", 6, nil, ErrRoll},
+		{"16x", 6, nil, ErrRoll},
+		{"2", 2, nil, ErrRoll},
+		{"13", 12, nil, ErrRoll},
+		{"0", 12, nil, ErrRoll},
+		{"-1", 20, nil, ErrRoll},
+		{"1", 1, nil, ErrSides},
+	}
+	for _, test := range tests {
+		rolls, err := Parse(test.s, test.sides)
+		if err != test.err {
+			t.Errorf("%q d%d: error %v, want %v", test.s, test.sides, err, test.err)
+			continue
+		}
+		if !reflect.DeepEqual(rolls, test.rolls) {
+			t.Errorf("%q d%d: rolls %v, want %v", test.s, test.sides, rolls, test.rolls)
+		}
+	}
+}
+
+func TestBits(t *testing.T) {
+	tests := []struct {
+		sides, bits, rolls int
+	}{
+		{2, 256, 256},
+		{6, 256, 100},
+		{6, 128, 50},
+		{10, 256, 78},
+		{20, 256, 60},
+	}
+	for _, test := range tests {
+		if got := Rolls(test.bits, test.sides); got != test.rolls {
+			t.Errorf("d%d: %d rolls for %d bits, want %d", test.sides, got, test.bits, test.rolls)
+		}
+		if got := Bits(test.rolls, test.sides); got < float64(test.bits) {
+			t.Errorf("d%d: %d rolls provide %.1f bits, want at least %d", test.sides, test.rolls, got, test.bits)
+		}
+		if got := Bits(test.rolls-1, test.sides); got >= float64(test.bits) {
+			t.Errorf("d%d: %d rolls provide %.1f bits, want less than %d", test.sides, test.rolls-1, got, test.bits)
+		}
+	}
+}
+
+func TestEntropy(t *testing.T) {
+	tests := []struct {
+		rolls     []int
+		sides     int
+		extra     []byte
+		canonical string
+		entropy   string
+	}{
+		// printf 163 | sha256sum
+		{[]int{1, 6, 3}, 6, nil, "163", "3d3286f7cd19074f04e514b0c6c237e757513fb32820698b790e1dec801d947a"},
+		{[]int{1, 0, 1, 0}, 2, nil, "1010", "7a5df5ffa0dec2228d90b8d0a0f1b0767b748b0a41314c123075b8289e4e053f"},
+		{[]int{10, 3, 12}, 12, nil, "10 3 12", "7fa75b075b1704c59fb2de7b7391f142014cd5dd8d0030bdea13968d2e309f06"},
+		{[]int{1, 6, 3}, 6, []byte{0, 1, 2, 3}, "163", "1587d7114bc2690861744460f1c15e58fe8da3c19c9ec0bb14b581b2866c521f"},
+	}
+	for _, test := range tests {
+		if got := Canonical(test.rolls, test.sides); got != test.canonical {
+			t.Errorf("%v: canonical %q, want %q", test.rolls, got, test.canonical)
+		}
+		if got := hex.EncodeToString(Entropy(test.rolls, test.sides, test.extra)); got != test.entropy {
+			t.Errorf("%v: entropy %s, want %s", test.rolls, got, test.entropy)
+		}
+	}
+}
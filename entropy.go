@@ -0,0 +1,86 @@
+// Copyright (C) 2014-15 Michail Kargakis
+// This source code is subject to the terms
+// of the MIT License
+
+package main
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math"
+	"os"
+
+	"github.com/kargakis/cryptowallet/bip39"
+	"github.com/kargakis/cryptowallet/dice"
+	"github.com/kargakis/cryptowallet/health"
+	"golang.org/x/term"
+)
+
+// keyBits is the entropy needed for a random private key.
+const keyBits = 256
+
+// systemMinEntropy is the min-entropy claimed for each byte of
+// system randomness. It stays below the 8 bits of a perfect source:
+// the tests are there to catch a broken source, not to certify full
+// entropy.
+const systemMinEntropy = 6
+
+// diceMinEntropy is the fraction of the entropy of a fair die claimed
+// for each roll, as hand-rolled dice are rarely fair.
+const diceMinEntropy = 0.5
+
+// checkSystemEntropy runs the start-up health tests on system
+// randomness and aborts before any key is generated when they fail.
+func checkSystemEntropy() {
+	result, err := health.Startup(rand.Reader, systemMinEntropy)
+	if result != nil {
+		debugf("Entropy health tests: %s", result)
+	}
+	debug(err, "System entropy failed health tests")
+	debugf("Entropy health tests passed")
+}
+
+// diceEntropy reads die rolls from stdin and returns the entropy
+// derived from them. It aborts when the rolls carry fewer bits of
+// min-entropy than the key calls for.
+func diceEntropy() io.Reader {
+	bits := keyBits
+	if conf.Mnemonic {
+		var err error
+		bits, err = bip39.EntropyBits(conf.Words)
+		debug(err, "Cannot generate mnemonic")
+	}
+	// Each roll is credited with the min-entropy the health tests
+	// claim, both for the rolls asked for and for those provided.
+	rollBits := diceMinEntropy * dice.Bits(1, conf.Sides)
+	needed := int(math.Ceil(float64(bits) / rollBits))
+	if term.IsTerminal(int(os.Stdin.Fd())) {
+		fmt.Printf("Enter at least %d rolls of a %d-sided die and finish with Ctrl-D:\n", needed, conf.Sides)
+	}
+	input, err := ioutil.ReadAll(os.Stdin)
+	debug(err, "Cannot read die rolls")
+	rolls, err := dice.Parse(string(input), conf.Sides)
+	debug(err, "Cannot parse die rolls")
+	result, err := health.TestRolls(rolls, rollBits)
+	debugf("Die roll health tests: %s", result)
+	debug(err, "Die rolls failed health tests")
+	if len(rolls) < needed {
+		fmt.Printf("%d rolls provide %.1f bits of min-entropy, %d rolls are needed for %d bits!\n", len(rolls), float64(len(rolls))*rollBits, needed, bits)
+		os.Exit(1)
+	}
+
+	// System randomness must be recorded for the key to be
+	// re-derived from the rolls.
+	var extra []byte
+	if conf.DiceMix {
+		extra = make([]byte, 32)
+		_, err := rand.Read(extra)
+		debug(err, "Cannot read system entropy")
+		fmt.Println("System entropy:", hex.EncodeToString(extra))
+	}
+	return bytes.NewReader(dice.Entropy(rolls, conf.Sides, extra))
+}
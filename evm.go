@@ -0,0 +1,111 @@
+// Copyright (C) 2014-15 Michail Kargakis
+// This source code is subject to the terms
+// of the MIT License
+
+package main
+
+import (
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/kargakis/cryptowallet/keystore"
+	"github.com/kargakis/cryptowallet/wallet"
+)
+
+// checkEVMFlags selects EVM addresses for EVM chains and rejects the
+// flags they have no use for: their keys are in hex rather than WIF,
+// their public keys are always uncompressed and they have no scripts.
+func checkEVMFlags() {
+	if conf.AddressType == defaultAddressType {
+		conf.AddressType = string(wallet.EVM)
+	}
+	if conf.Uncompressed || conf.BIP38 || conf.IntermediateCode != "" || conf.Vanity != "" || conf.SplitKey || conf.Combine != "" || conf.Multisig > 0 {
+		fmt.Println("Coin type " + conf.CoinType + " is an EVM chain and cannot be used with --uncompressed, --bip38, --intermediate-code, --vanity, --split-key, --combine or --multisig")
+		os.Exit(1)
+	}
+	if (conf.Keystore || conf.ExportKeystore) && conf.Shares > 0 {
+		fmt.Println("--keystore and --export-keystore cannot be used with --shares")
+		os.Exit(1)
+	}
+	if conf.Keystore && !layout.FitsKeystore() {
+		fmt.Println("Layout " + conf.Layout + " has no room for a keystore")
+		os.Exit(1)
+	}
+	switch keystore.KDF(conf.KDF) {
+	case keystore.Scrypt, keystore.PBKDF2:
+	default:
+		fmt.Println("KDF " + conf.KDF + " not supported! Available: scrypt, pbkdf2")
+		os.Exit(1)
+	}
+}
+
+// chainLabel returns the label of the addresses of EVM chains, which
+// are alike on all of them, or an empty string for other coins.
+func chainLabel() string {
+	if !network.EVM {
+		return ""
+	}
+	return fmt.Sprintf("%s, chain ID %d", network.Name, network.ChainID)
+}
+
+// keystoreParams returns the key derivation parameters of keystores
+// selected by --kdf.
+func keystoreParams() *keystore.Params {
+	if keystore.KDF(conf.KDF) == keystore.PBKDF2 {
+		return keystore.StandardPBKDF2
+	}
+	return keystore.Standard
+}
+
+// exportKeystores writes the keystore of each key to its own file,
+// named as geth names them so it can be copied into the keystore
+// directory of geth as well as imported into MetaMask.
+func exportKeystores(keys []*wallet.PrivKey) {
+	for _, pk := range keys {
+		name := keystoreFile(pk, time.Now().UTC())
+		f, err := os.OpenFile(name, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+		debug(err, "Cannot create "+name)
+		_, err = f.WriteString(pk.Keystore())
+		if closeErr := f.Close(); err == nil {
+			err = closeErr
+		}
+		debug(err, "Cannot write "+name)
+		fmt.Println("Successfully exported " + name)
+	}
+}
+
+// keystoreFile returns the name of the keystore file of pk written at
+// t: UTC--<time>--<address>.
+func keystoreFile(pk *wallet.PrivKey, t time.Time) string {
+	return fmt.Sprintf("UTC--%s--%s", t.Format("2006-01-02T15-04-05.000000000Z"), strings.ToLower(strings.TrimPrefix(pk.Address().String(), "0x")))
+}
+
+// decryptKeystore decrypts a keystore file with a password read from
+// the terminal and prints the private key it holds along with its
+// address, after checking it against the address the keystore names.
+func decryptKeystore(name string) {
+	data, err := ioutil.ReadFile(name)
+	debug(err, "Cannot read "+name)
+	key, address, err := keystore.Decrypt(data, readPassphrase("Keystore password: ", false))
+	debug(err, "Cannot decrypt "+name)
+	if d := new(big.Int).SetBytes(key); d.Sign() == 0 || d.Cmp(btcec.S256().N) >= 0 {
+		fmt.Println("Keystore holds an invalid private key!")
+		os.Exit(1)
+	}
+	ecKey, _ := btcec.PrivKeyFromBytes(btcec.S256(), key)
+	pk, err := wallet.PrivKeyFromEC(network, &wallet.Options{AddressType: wallet.EVM}, ecKey)
+	debug(err, "Cannot encode private key")
+	if address != nil && !strings.EqualFold(pk.Address().String(), "0x"+hex.EncodeToString(address)) {
+		fmt.Println("Keystore address does not match its private key!")
+		os.Exit(1)
+	}
+	fmt.Println(pk)
+	fmt.Println(pk.Address())
+	fmt.Println("Chain:", chainLabel())
+}
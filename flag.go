@@ -4,26 +4,155 @@
 
 package main
 
+import (
+	"github.com/kargakis/cryptowallet/keystore"
+	"github.com/kargakis/cryptowallet/wallet"
+)
+
 const (
-	defaultDumpString = false
-	defaultDebug      = false
-	defaultTestnet    = false
-	defaultCoinType   = "btc"
-	defaultSupport    = false
+	defaultDumpString       = false
+	defaultDebug            = false
+	defaultTestnet          = false
+	defaultCoinType         = "btc"
+	defaultSupport          = false
+	defaultMnemonic         = false
+	defaultWords            = 12
+	defaultPassphrase       = false
+	defaultPath             = ""
+	defaultXPub             = false
+	defaultUncompressed     = false
+	defaultAddressType      = string(wallet.P2PKH)
+	defaultRedeemScript     = false
+	defaultBIP38            = false
+	defaultDecrypt          = ""
+	defaultIntermediate     = false
+	defaultLot              = -1
+	defaultSequence         = 0
+	defaultIntermediateCode = ""
+	defaultConfirm          = ""
+	defaultCount            = 1
+	defaultSeparate         = false
+	defaultManifest         = ""
+	defaultManifestPrivate  = false
+	defaultDice             = false
+	defaultSides            = 6
+	defaultDiceMix          = false
+	defaultVanity           = ""
+	defaultWorkers          = 0
+	defaultSplitKey         = false
+	defaultVanityPubKey     = ""
+	defaultCombine          = ""
+	defaultExpect           = ""
+	defaultShares           = 0
+	defaultThreshold        = 0
+	defaultRecover          = false
+	defaultMultisig         = 0
+	defaultCosigners        = 0
+	defaultVerify           = false
+	defaultScan             = ""
+	defaultLayout           = "a4"
+	defaultCoins            = ""
+	defaultKeystore         = false
+	defaultExportKeystore   = false
+	defaultKDF              = string(keystore.Scrypt)
+	defaultDecryptKeystore  = ""
 )
 
 type config struct {
-	DumpString bool   `long:"dump" description:"Dump WIF and pay-to-pubkey address as strings"`
-	Debug      bool   `long:"debug" description:"Enable debug logging"`
-	Testnet    bool   `long:"testnet" description:"Testnet network"`
-	CoinType   string `long:"coin" description:"Coin type"`
-	Support    bool   `long:"support" description:"Show supported cryptocurrencies"`
+	DumpString       bool     `long:"dump" description:"Dump WIF and pay-to-pubkey address as strings"`
+	Debug            bool     `long:"debug" description:"Enable debug logging"`
+	Testnet          bool     `long:"testnet" description:"Testnet network"`
+	CoinType         string   `long:"coin" description:"Coin type"`
+	Support          bool     `long:"support" description:"Show supported cryptocurrencies"`
+	Mnemonic         bool     `long:"mnemonic" description:"Derive the private key from a new BIP39 mnemonic"`
+	Words            int      `long:"words" description:"Number of mnemonic words (12, 15, 18, 21 or 24)"`
+	Passphrase       bool     `long:"passphrase" description:"Protect the mnemonic with a BIP39 passphrase or the shares with a SLIP-39 passphrase, read from the terminal"`
+	Path             string   `long:"path" description:"BIP32 derivation path of the mnemonic key (default m/purpose'/coin'/0'/0/0)"`
+	XPub             bool     `long:"xpub" description:"Print the account extended public key"`
+	Uncompressed     bool     `long:"uncompressed" description:"Use a legacy uncompressed public key and address"`
+	AddressType      string   `long:"address" description:"Address type (p2pkh, p2wpkh, p2sh-p2wpkh, p2tr; p2sh, p2wsh, p2sh-p2wsh with --multisig; evm for EVM chains)"`
+	RedeemScript     bool     `long:"redeem-script" description:"Print the redeem script of a p2sh-p2wpkh address"`
+	BIP38            bool     `long:"bip38" description:"Encrypt the private key with a BIP38 passphrase"`
+	Decrypt          string   `long:"decrypt" description:"Decrypt a BIP38 encrypted private key"`
+	Intermediate     bool     `long:"intermediate" description:"Create a BIP38 passphrase intermediate code"`
+	Lot              int      `long:"lot" description:"Lot number embedded in the intermediate code"`
+	Sequence         int      `long:"sequence" description:"Sequence number embedded in the intermediate code"`
+	IntermediateCode string   `long:"intermediate-code" description:"Generate a BIP38 encrypted private key from an intermediate code"`
+	Confirm          string   `long:"confirm" description:"Verify a BIP38 confirmation code"`
+	Count            int      `long:"count" description:"Number of wallets to generate"`
+	Separate         bool     `long:"separate" description:"Generate one pdf per wallet instead of a multi-page pdf"`
+	Manifest         string   `long:"manifest" description:"Write the generated addresses to a CSV or JSON (.json) file"`
+	ManifestPrivate  bool     `long:"manifest-private" description:"Include the private keys in the manifest"`
+	Dice             bool     `long:"dice" description:"Derive the private key from die rolls or coin flips read from stdin"`
+	Sides            int      `long:"sides" description:"Number of sides of the die, 2 for coin flips"`
+	DiceMix          bool     `long:"dice-mix" description:"Mix system randomness into the dice entropy"`
+	Vanity           string   `long:"vanity" description:"Search for an address starting with the given prefix"`
+	Workers          int      `long:"workers" description:"Number of vanity search workers (default one per CPU)"`
+	SplitKey         bool     `long:"split-key" description:"Create a secret key and the public key to hand out for a split-key vanity search"`
+	VanityPubKey     string   `long:"vanity-pubkey" description:"Search for a partial key combined with the given public key of a split-key search"`
+	Combine          string   `long:"combine" description:"Combine a partial key found by a split-key search with the secret key"`
+	Expect           string   `long:"expect" description:"Address the combined key of --combine must have"`
+	Shares           int      `long:"shares" description:"Split the private key into the given number of SLIP-39 shares"`
+	Threshold        int      `long:"threshold" description:"Number of shares needed to recover the private key"`
+	Recover          bool     `long:"recover" description:"Recover a private key from SLIP-39 shares read from stdin"`
+	Multisig         int      `long:"multisig" description:"Generate a multisig address spendable by the given number of cosigners (p2sh, p2wsh, p2sh-p2wsh)"`
+	Cosigners        int      `long:"cosigners" description:"Number of multisig cosigner keys to generate"`
+	PubKeys          []string `long:"pubkey" description:"Public key of an external multisig cosigner, may be repeated"`
+	Verify           bool     `long:"verify" description:"Verify private keys in WIF read from stdin and print their addresses"`
+	Scan             string   `long:"scan" description:"Decode the QR codes of a PNG or JPEG image of a wallet and verify the private keys among them"`
+	Layout           string   `long:"layout" description:"Paper wallet layout (a4, letter, trifold, card, business-card, stickers)"`
+	Coins            string   `long:"coins" description:"YAML or JSON file of coins to add to or override the supported ones"`
+	Keystore         bool     `long:"keystore" description:"Also encrypt the private key of an EVM chain in a Web3 Secret Storage keystore with a password"`
+	ExportKeystore   bool     `long:"export-keystore" description:"Write the private key of an EVM chain to a Web3 Secret Storage keystore file encrypted with a password"`
+	KDF              string   `long:"kdf" description:"Key derivation function of keystores (scrypt, pbkdf2)"`
+	DecryptKeystore  string   `long:"decrypt-keystore" description:"Decrypt a Web3 Secret Storage keystore file and print its private key and address"`
 }
 
 var conf = &config{
-	DumpString: defaultDumpString,
-	Debug:      defaultDebug,
-	Testnet:    defaultTestnet,
-	CoinType:   defaultCoinType,
-	Support:    defaultSupport,
+	DumpString:       defaultDumpString,
+	Debug:            defaultDebug,
+	Testnet:          defaultTestnet,
+	CoinType:         defaultCoinType,
+	Support:          defaultSupport,
+	Mnemonic:         defaultMnemonic,
+	Words:            defaultWords,
+	Passphrase:       defaultPassphrase,
+	Path:             defaultPath,
+	XPub:             defaultXPub,
+	Uncompressed:     defaultUncompressed,
+	AddressType:      defaultAddressType,
+	RedeemScript:     defaultRedeemScript,
+	BIP38:            defaultBIP38,
+	Decrypt:          defaultDecrypt,
+	Intermediate:     defaultIntermediate,
+	Lot:              defaultLot,
+	Sequence:         defaultSequence,
+	IntermediateCode: defaultIntermediateCode,
+	Confirm:          defaultConfirm,
+	Count:            defaultCount,
+	Separate:         defaultSeparate,
+	Manifest:         defaultManifest,
+	ManifestPrivate:  defaultManifestPrivate,
+	Dice:             defaultDice,
+	Sides:            defaultSides,
+	DiceMix:          defaultDiceMix,
+	Vanity:           defaultVanity,
+	Workers:          defaultWorkers,
+	SplitKey:         defaultSplitKey,
+	VanityPubKey:     defaultVanityPubKey,
+	Combine:          defaultCombine,
+	Expect:           defaultExpect,
+	Shares:           defaultShares,
+	Threshold:        defaultThreshold,
+	Recover:          defaultRecover,
+	Multisig:         defaultMultisig,
+	Cosigners:        defaultCosigners,
+	Verify:           defaultVerify,
+	Scan:             defaultScan,
+	Layout:           defaultLayout,
+	Coins:            defaultCoins,
+	Keystore:         defaultKeystore,
+	ExportKeystore:   defaultExportKeystore,
+	KDF:              defaultKDF,
+	DecryptKeystore:  defaultDecryptKeystore,
 }
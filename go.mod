@@ -0,0 +1,20 @@
+module github.com/kargakis/cryptowallet
+
+go 1.20
+
+require (
+	github.com/btcsuite/btcd v0.22.1
+	github.com/btcsuite/btcutil v1.0.3-0.20201208143702-a53e38424cce
+	github.com/jessevdk/go-flags v1.5.0
+	github.com/jung-kurt/gofpdf v1.16.2
+	golang.org/x/crypto v0.17.0
+	golang.org/x/term v0.15.0
+	golang.org/x/text v0.14.0
+	gopkg.in/yaml.v2 v2.4.0
+	rsc.io/qr v0.2.0
+)
+
+require (
+	github.com/btcsuite/btcd/chaincfg/chainhash v1.0.1 // indirect
+	golang.org/x/sys v0.15.0 // indirect
+)
@@ -0,0 +1,181 @@
+// Copyright (C) 2014-15 Michail Kargakis
+// This source code is subject to the terms
+// of the MIT License
+
+// Package health implements the start-up health tests of NIST SP
+// 800-90B, section 4.4, for entropy sources producing bytes or die
+// rolls.
+package health
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"math"
+)
+
+const (
+	// StartupSamples is the number of samples tested at start-up.
+	StartupSamples = 1024
+
+	// alpha is the false positive probability of each test.
+	alpha = 1.0 / (1 << 20)
+
+	// window is the adaptive proportion test window for non-binary
+	// samples. Fewer samples are tested in a single shorter window.
+	window = 512
+)
+
+var (
+	// ErrRepetitionCount is returned when a sample repeats more
+	// often in a row than the claimed entropy allows.
+	ErrRepetitionCount = errors.New("health: repetition count test failed")
+
+	// ErrAdaptiveProportion is returned when a sample is more
+	// frequent within a window than the claimed entropy allows.
+	ErrAdaptiveProportion = errors.New("health: adaptive proportion test failed")
+)
+
+// Result holds the outcome of the start-up tests.
+type Result struct {
+	// Samples is the number of samples tested.
+	Samples int
+	// MinEntropy is the claimed min-entropy per sample in bits.
+	MinEntropy float64
+
+	// RepetitionCutoff is the cutoff of the repetition count test
+	// and LongestRun the longest run of a repeated sample.
+	RepetitionCutoff int
+	LongestRun       int
+
+	// ProportionCutoff is the cutoff of the adaptive proportion test
+	// and MaxProportion the highest count of the first sample of a
+	// window of Window samples within the window.
+	Window           int
+	ProportionCutoff int
+	MaxProportion    int
+}
+
+func (r *Result) String() string {
+	return fmt.Sprintf("%d samples at %.1f bits: longest run %d (cutoff %d), max proportion %d/%d (cutoff %d)",
+		r.Samples, r.MinEntropy, r.LongestRun, r.RepetitionCutoff, r.MaxProportion, r.Window, r.ProportionCutoff)
+}
+
+// Startup reads StartupSamples bytes from source and runs the
+// repetition count and adaptive proportion tests on them, given a
+// claimed min-entropy of minEntropy bits per byte. The result is
+// returned along with an error if either test failed.
+func Startup(source io.Reader, minEntropy float64) (*Result, error) {
+	samples := make([]byte, StartupSamples)
+	if _, err := io.ReadFull(source, samples); err != nil {
+		return nil, err
+	}
+	return Test(samples, minEntropy)
+}
+
+// Test runs the repetition count and adaptive proportion tests on
+// samples, given a claimed min-entropy of minEntropy bits per byte.
+func Test(samples []byte, minEntropy float64) (*Result, error) {
+	ints := make([]int, len(samples))
+	for i, s := range samples {
+		ints[i] = int(s)
+	}
+	return TestRolls(ints, minEntropy)
+}
+
+// TestRolls runs the repetition count and adaptive proportion tests
+// on die rolls, given a claimed min-entropy of minEntropy bits per
+// roll.
+func TestRolls(rolls []int, minEntropy float64) (*Result, error) {
+	w := window
+	if len(rolls) < w {
+		w = len(rolls)
+	}
+	r := &Result{
+		Samples:          len(rolls),
+		MinEntropy:       minEntropy,
+		RepetitionCutoff: RepetitionCutoff(minEntropy),
+		Window:           w,
+		ProportionCutoff: proportionCutoff(minEntropy, w),
+	}
+	r.LongestRun = longestRun(rolls)
+	r.MaxProportion = maxProportion(rolls, w)
+
+	if r.LongestRun >= r.RepetitionCutoff {
+		return r, ErrRepetitionCount
+	}
+	if r.MaxProportion >= r.ProportionCutoff {
+		return r, ErrAdaptiveProportion
+	}
+	return r, nil
+}
+
+// RepetitionCutoff returns the number of identical consecutive
+// samples that fails the repetition count test.
+func RepetitionCutoff(minEntropy float64) int {
+	return 1 + int(math.Ceil(-math.Log2(alpha)/minEntropy))
+}
+
+// ProportionCutoff returns the count of a sample within a window of
+// 512 samples that fails the adaptive proportion test. It is one
+// more than the smallest count whose binomial cumulative probability
+// reaches 1-alpha.
+func ProportionCutoff(minEntropy float64) int {
+	return proportionCutoff(minEntropy, window)
+}
+
+// proportionCutoff returns the cutoff of the adaptive proportion test
+// for windows of w samples.
+func proportionCutoff(minEntropy float64, w int) int {
+	p := math.Pow(2, -minEntropy)
+	cdf := 0.0
+	for k := 0; k <= w; k++ {
+		cdf += binomial(w, k, p)
+		if cdf >= 1-alpha {
+			return k + 1
+		}
+	}
+	return w
+}
+
+// binomial returns the probability of k successes in n trials of
+// probability p.
+func binomial(n, k int, p float64) float64 {
+	nf, _ := math.Lgamma(float64(n + 1))
+	kf, _ := math.Lgamma(float64(k + 1))
+	rf, _ := math.Lgamma(float64(n - k + 1))
+	return math.Exp(nf - kf - rf + float64(k)*math.Log(p) + float64(n-k)*math.Log1p(-p))
+}
+
+func longestRun(samples []int) int {
+	longest, run := 0, 0
+	for i, s := range samples {
+		if i > 0 && s == samples[i-1] {
+			run++
+		} else {
+			run = 1
+		}
+		if run > longest {
+			longest = run
+		}
+	}
+	return longest
+}
+
+// maxProportion counts the occurrences of the first sample of each
+// window of w samples within the window and returns the highest count.
+func maxProportion(samples []int, w int) int {
+	max := 0
+	for start := 0; w > 0 && start+w <= len(samples); start += w {
+		count := 0
+		for _, s := range samples[start : start+w] {
+			if s == samples[start] {
+				count++
+			}
+		}
+		if count > max {
+			max = count
+		}
+	}
+	return max
+}
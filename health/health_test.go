@@ -0,0 +1,66 @@
+// Copyright (C) 2014-15 Michail Kargakis
+// This source code is subject to the terms
+// of the MIT License
+
+package health
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestDieRolls(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	fair := make([]int, 120)
+	for i := range fair {
+		fair[i] = 1 + r.Intn(6)
+	}
+	stuck := make([]int, 120)
+	for i := range stuck {
+		stuck[i] = 1
+		// Break the runs so that only the proportion test fails.
+		if i%3 == 2 {
+			stuck[i] = 2
+		}
+	}
+	tests := []struct {
+		name  string
+		rolls []int
+		err   error
+	}{
+		{"fair", fair, nil},
+		{"short", fair[:20], nil},
+		{"one face", make([]int, 120), ErrRepetitionCount},
+		{"biased", stuck, ErrAdaptiveProportion},
+	}
+	for _, test := range tests {
+		result, err := TestRolls(test.rolls, 1.29)
+		if err != test.err {
+			t.Errorf("%s: %v, want %v (%s)", test.name, err, test.err, result)
+		}
+	}
+}
+
+func TestCutoffs(t *testing.T) {
+	// The cutoffs of NIST SP 800-90B, section 4.4, for alpha 2^-20
+	// and the window of 512 samples.
+	tests := []struct {
+		minEntropy float64
+		repetition int
+		proportion int
+	}{
+		{8, 4, 13},
+		{4, 6, 62},
+		{2, 11, 177},
+		{1, 21, 311},
+		{0.5, 41, 410},
+	}
+	for _, test := range tests {
+		if got := RepetitionCutoff(test.minEntropy); got != test.repetition {
+			t.Errorf("%v bits: repetition cutoff %d, want %d", test.minEntropy, got, test.repetition)
+		}
+		if got := ProportionCutoff(test.minEntropy); got != test.proportion {
+			t.Errorf("%v bits: proportion cutoff %d, want %d", test.minEntropy, got, test.proportion)
+		}
+	}
+}
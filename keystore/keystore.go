@@ -0,0 +1,259 @@
+// Copyright (C) 2014-15 Michail Kargakis
+// This source code is subject to the terms
+// of the MIT License
+
+// Package keystore implements the version 3 Web3 Secret Storage
+// keystores EVM wallets such as MetaMask and geth import private keys
+// from.
+package keystore
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+
+	"golang.org/x/crypto/pbkdf2"
+	"golang.org/x/crypto/scrypt"
+	"golang.org/x/crypto/sha3"
+)
+
+const (
+	version    = 3
+	cipherName = "aes-128-ctr"
+	// dkLen is the length of derived keys: the first half is the AES
+	// key and the second half authenticates the ciphertext.
+	dkLen = 32
+	// prf is the only pseudorandom function of pbkdf2 keystores.
+	prf = "hmac-sha256"
+
+	// Bounds of the key derivation parameters read from keystores,
+	// so that crafted ones cannot exhaust memory or CPU. The scrypt
+	// bounds take up to 1 GiB of memory, 4 times as much as
+	// Standard, and up to 16 times its work.
+	maxDKLen     = 64
+	maxScryptN   = 1 << 20
+	maxScryptRP  = 16
+	maxScryptMem = 1 << 30
+	maxPBKDF2C   = 1 << 22
+)
+
+// KDF is the key derivation function of a keystore.
+type KDF string
+
+// Supported key derivation functions.
+const (
+	Scrypt KDF = "scrypt"
+	PBKDF2 KDF = "pbkdf2"
+)
+
+var (
+	// ErrKDF is returned for unsupported key derivation functions or
+	// parameters.
+	ErrKDF = errors.New("keystore: unsupported key derivation function")
+
+	// ErrInvalidKeystore is returned for malformed keystores and those
+	// of other versions or ciphers.
+	ErrInvalidKeystore = errors.New("keystore: invalid keystore")
+
+	// ErrPassword is returned when the password does not match the
+	// keystore.
+	ErrPassword = errors.New("keystore: wrong password")
+)
+
+// Params are the key derivation parameters of a keystore.
+type Params struct {
+	KDF KDF
+	// N, R and P are the cost parameters of scrypt.
+	N, R, P int
+	// C is the number of pbkdf2 iterations.
+	C int
+}
+
+var (
+	// Standard are the parameters of keystores written by geth.
+	Standard = &Params{KDF: Scrypt, N: 1 << 18, R: 8, P: 1}
+
+	// StandardPBKDF2 are the pbkdf2 parameters of the Web3 Secret
+	// Storage test vectors, for wallets without scrypt.
+	StandardPBKDF2 = &Params{KDF: PBKDF2, C: 1 << 18}
+)
+
+type keyJSON struct {
+	Address string     `json:"address,omitempty"`
+	Crypto  cryptoJSON `json:"crypto"`
+	ID      string     `json:"id"`
+	Version int        `json:"version"`
+}
+
+type cryptoJSON struct {
+	Cipher       string           `json:"cipher"`
+	CipherText   string           `json:"ciphertext"`
+	CipherParams cipherParamsJSON `json:"cipherparams"`
+	KDF          KDF              `json:"kdf"`
+	KDFParams    kdfParamsJSON    `json:"kdfparams"`
+	MAC          string           `json:"mac"`
+}
+
+type cipherParamsJSON struct {
+	IV string `json:"iv"`
+}
+
+// kdfParamsJSON holds the parameters of both key derivation
+// functions. Those of the other one are left out.
+type kdfParamsJSON struct {
+	DKLen int    `json:"dklen"`
+	Salt  string `json:"salt"`
+	N     int    `json:"n,omitempty"`
+	R     int    `json:"r,omitempty"`
+	P     int    `json:"p,omitempty"`
+	C     int    `json:"c,omitempty"`
+	PRF   string `json:"prf,omitempty"`
+}
+
+// Encrypt returns the keystore of the 32-byte private key of the
+// 20-byte account address, encrypted with password using a key
+// derived as params call for.
+func Encrypt(key, address []byte, password string, params *Params) ([]byte, error) {
+	salt := make([]byte, 32)
+	iv := make([]byte, aes.BlockSize)
+	id := make([]byte, 16)
+	for _, b := range [][]byte{salt, iv, id} {
+		if _, err := io.ReadFull(rand.Reader, b); err != nil {
+			return nil, err
+		}
+	}
+	kdfParams := kdfParamsJSON{DKLen: dkLen, Salt: hex.EncodeToString(salt)}
+	switch params.KDF {
+	case Scrypt:
+		kdfParams.N, kdfParams.R, kdfParams.P = params.N, params.R, params.P
+	case PBKDF2:
+		kdfParams.C, kdfParams.PRF = params.C, prf
+	}
+	derived, err := deriveKey(password, params.KDF, &kdfParams)
+	if err != nil {
+		return nil, err
+	}
+	cipherText, err := aesCTR(derived[:16], iv, key)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(&keyJSON{
+		Address: hex.EncodeToString(address),
+		Crypto: cryptoJSON{
+			Cipher:       cipherName,
+			CipherText:   hex.EncodeToString(cipherText),
+			CipherParams: cipherParamsJSON{IV: hex.EncodeToString(iv)},
+			KDF:          params.KDF,
+			KDFParams:    kdfParams,
+			MAC:          hex.EncodeToString(mac(derived, cipherText)),
+		},
+		ID:      uuid(id),
+		Version: version,
+	})
+}
+
+// Decrypt returns the 32-byte private key held by a keystore
+// encrypted with password along with the account address it names,
+// nil when it names none. The address is not checked against the key.
+func Decrypt(data []byte, password string) (key, address []byte, err error) {
+	var k keyJSON
+	if err := json.Unmarshal(data, &k); err != nil {
+		return nil, nil, ErrInvalidKeystore
+	}
+	if k.Version != version || k.Crypto.Cipher != cipherName {
+		return nil, nil, ErrInvalidKeystore
+	}
+	if k.Address != "" {
+		if address, err = hex.DecodeString(k.Address); err != nil || len(address) != 20 {
+			return nil, nil, ErrInvalidKeystore
+		}
+	}
+	cipherText, err := hex.DecodeString(k.Crypto.CipherText)
+	if err != nil || len(cipherText) == 0 || len(cipherText) > 32 {
+		return nil, nil, ErrInvalidKeystore
+	}
+	iv, err := hex.DecodeString(k.Crypto.CipherParams.IV)
+	if err != nil || len(iv) != aes.BlockSize {
+		return nil, nil, ErrInvalidKeystore
+	}
+	wantMAC, err := hex.DecodeString(k.Crypto.MAC)
+	if err != nil {
+		return nil, nil, ErrInvalidKeystore
+	}
+
+	derived, err := deriveKey(password, k.Crypto.KDF, &k.Crypto.KDFParams)
+	if err != nil {
+		return nil, nil, err
+	}
+	if subtle.ConstantTimeCompare(mac(derived, cipherText), wantMAC) != 1 {
+		return nil, nil, ErrPassword
+	}
+	if key, err = aesCTR(derived[:16], iv, cipherText); err != nil {
+		return nil, nil, err
+	}
+	// Some wallets drop the leading zero bytes of keys.
+	return append(make([]byte, 32-len(key)), key...), address, nil
+}
+
+// deriveKey derives the key encrypting and authenticating a keystore
+// from password.
+func deriveKey(password string, kdf KDF, params *kdfParamsJSON) ([]byte, error) {
+	salt, err := hex.DecodeString(params.Salt)
+	if err != nil {
+		return nil, ErrInvalidKeystore
+	}
+	if params.DKLen < dkLen || params.DKLen > maxDKLen {
+		return nil, ErrKDF
+	}
+	switch kdf {
+	case Scrypt:
+		if params.N > maxScryptN || params.R < 1 || params.P < 1 || params.R*params.P > maxScryptRP ||
+			128*params.N*params.R > maxScryptMem {
+			return nil, ErrKDF
+		}
+		derived, err := scrypt.Key([]byte(password), salt, params.N, params.R, params.P, params.DKLen)
+		if err != nil {
+			return nil, ErrKDF
+		}
+		return derived, nil
+	case PBKDF2:
+		if params.PRF != prf || params.C < 1 || params.C > maxPBKDF2C {
+			return nil, ErrKDF
+		}
+		return pbkdf2.Key([]byte(password), salt, params.C, params.DKLen, sha256.New), nil
+	}
+	return nil, ErrKDF
+}
+
+func aesCTR(key, iv, in []byte) ([]byte, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]byte, len(in))
+	cipher.NewCTR(block, iv).XORKeyStream(out, in)
+	return out, nil
+}
+
+// mac authenticates cipherText with the second half of the derived
+// key.
+func mac(derived, cipherText []byte) []byte {
+	h := sha3.NewLegacyKeccak256()
+	h.Write(derived[16:32])
+	h.Write(cipherText)
+	return h.Sum(nil)
+}
+
+// uuid formats 16 random bytes as a version 4 UUID.
+func uuid(b []byte) string {
+	b[6] = b[6]&0x0f | 0x40
+	b[8] = b[8]&0x3f | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:])
+}
@@ -0,0 +1,130 @@
+// Copyright (C) 2014-15 Michail Kargakis
+// This source code is subject to the terms
+// of the MIT License
+
+package keystore
+
+import (
+	"bytes"
+	"encoding/hex"
+	"strings"
+	"testing"
+)
+
+// vectors are the Web3 Secret Storage test vectors along with keys
+// missing their leading zero bytes, as written by some wallets.
+var vectors = []struct {
+	name, json, password, key string
+}{
+	{
+		"pbkdf2",
+		`{"crypto":{"cipher":"aes-128-ctr","cipherparams":{"iv":"6087dab2f9fdbbfaddc31a909735c1e6"},"ciphertext":"5318b4d5bcd28de64ee5559e671353e16f075ecae9f99c7a79a38af5f869aa46","kdf":"pbkdf2","kdfparams":{"c":262144,"dklen":32,"prf":"hmac-sha256","salt":"ae3cd4e7013836a3df6bd7241b12db061dbe2c6785853cce422d148a624ce0bd"},"mac":"517ead924a9d0dc3124507e3393d175ce3ff7c1e96529c6c555ce9e51205e9b2"},"id":"3198bc9c-6672-5ab3-d995-4942343ae5b6","version":3}`,
+		"testpassword",
+		"7a28b5ba57c53603b0b07b56bba752f7784bf506fa95edc395f5cf6c7514fe9d",
+	},
+	{
+		"scrypt",
+		`{"crypto":{"cipher":"aes-128-ctr","cipherparams":{"iv":"83dbcc02d8ccb40e466191a123791e0e"},"ciphertext":"d172bf743a674da9cdad04534d56926ef8358534d458fffccd4e6ad2fbde479c","kdf":"scrypt","kdfparams":{"dklen":32,"n":262144,"r":1,"p":8,"salt":"ab0c7876052600dd703518d6fc3fe8984592145b591fc8fb5c6d43190334ba19"},"mac":"2103ac29920d71da29f15d75b4a16dbe95cfd7ff8faea1056c33131d846e3097"},"id":"3198bc9c-6672-5ab3-d995-4942343ae5b6","version":3}`,
+		"testpassword",
+		"7a28b5ba57c53603b0b07b56bba752f7784bf506fa95edc395f5cf6c7514fe9d",
+	},
+	{
+		"31-byte key",
+		`{"crypto":{"cipher":"aes-128-ctr","cipherparams":{"iv":"e0c41130a323adc1446fc82f724bca2f"},"ciphertext":"9517cd5bdbe69076f9bf5057248c6c050141e970efa36ce53692d5d59a3984","kdf":"scrypt","kdfparams":{"dklen":32,"n":2,"r":8,"p":1,"salt":"711f816911c92d649fb4c84b047915679933555030b3552c1212609b38208c63"},"mac":"d5e116151c6aa71470e67a7d42c9620c75c4d23229847dcc127794f0732b0db5"},"id":"fecfc4ce-e956-48fd-953b-30f8b52ed66c","version":3}`,
+		"foo",
+		"00fa7b3db73dc7dfdf8c5fbdb796d741e4488628c41fc4febd9160a866ba0f35",
+	},
+	{
+		"30-byte key",
+		`{"crypto":{"cipher":"aes-128-ctr","cipherparams":{"iv":"3ca92af36ad7c2cd92454c59cea5ef00"},"ciphertext":"108b7d34f3442fc26ab1ab90ca91476ba6bfa8c00975a49ef9051dc675aa","kdf":"scrypt","kdfparams":{"dklen":32,"n":2,"r":8,"p":1,"salt":"d0769e608fb86cda848065642a9c6fa046845c928175662b8e356c77f914cd3b"},"mac":"75d0e6759f7b3cefa319c3be41680ab6beea7d8328653474bd06706d4cc67420"},"id":"a37e1559-5955-450d-8075-7b8931b392b2","version":3}`,
+		"foo",
+		"000081c29e8142bb6a81bef5a92bda7a8328a5c85bb2f9542e76f9b0f94fc018",
+	},
+}
+
+func TestVectors(t *testing.T) {
+	for _, v := range vectors {
+		key, address, err := Decrypt([]byte(v.json), v.password)
+		if err != nil {
+			t.Errorf("%s: %v", v.name, err)
+			continue
+		}
+		if got := hex.EncodeToString(key); got != v.key {
+			t.Errorf("%s: key %s, want %s", v.name, got, v.key)
+		}
+		if address != nil {
+			t.Errorf("%s: address %x, want none", v.name, address)
+		}
+	}
+}
+
+func TestWrongPassword(t *testing.T) {
+	for _, v := range vectors {
+		if _, _, err := Decrypt([]byte(v.json), v.password+"x"); err != ErrPassword {
+			t.Errorf("%s: %v, want %v", v.name, err, ErrPassword)
+		}
+	}
+}
+
+func TestEncrypt(t *testing.T) {
+	key, _ := hex.DecodeString(vectors[0].key)
+	address, _ := hex.DecodeString("008aeeda4d805471df9b2a5b0f38a0c3bcba786b")
+	// Cheap parameters keep the test fast.
+	for _, params := range []*Params{
+		{KDF: Scrypt, N: 1 << 12, R: 8, P: 1},
+		{KDF: PBKDF2, C: 1 << 12},
+	} {
+		data, err := Encrypt(key, address, "testpassword", params)
+		if err != nil {
+			t.Fatalf("%s: %v", params.KDF, err)
+		}
+		gotKey, gotAddress, err := Decrypt(data, "testpassword")
+		if err != nil {
+			t.Fatalf("%s: %v", params.KDF, err)
+		}
+		if !bytes.Equal(gotKey, key) || !bytes.Equal(gotAddress, address) {
+			t.Errorf("%s: decrypted %x of %x, want %x of %x", params.KDF, gotKey, gotAddress, key, address)
+		}
+		if _, _, err := Decrypt(data, "wrong"); err != ErrPassword {
+			t.Errorf("%s: wrong password: %v, want %v", params.KDF, err, ErrPassword)
+		}
+	}
+}
+
+func TestInvalid(t *testing.T) {
+	if _, err := Encrypt(make([]byte, 32), nil, "pw", &Params{KDF: "argon2"}); err != ErrKDF {
+		t.Errorf("unknown KDF: %v, want %v", err, ErrKDF)
+	}
+	for _, data := range []string{
+		"",
+		`{"version":1}`,
+		`{"version":3,"crypto":{"cipher":"aes-128-cbc"}}`,
+	} {
+		if _, _, err := Decrypt([]byte(data), "pw"); err != ErrInvalidKeystore {
+			t.Errorf("%q: %v, want %v", data, err, ErrInvalidKeystore)
+		}
+	}
+}
+
+func TestKDFBounds(t *testing.T) {
+	tests := []struct {
+		vector   int
+		from, to string
+	}{
+		{2, `"n":2,`, `"n":2097152,`},
+		{2, `"n":2,"r":8,`, `"n":1048576,"r":16,`},
+		{2, `"r":8,"p":1`, `"r":0,"p":1`},
+		{2, `"r":8,"p":1`, `"r":8,"p":0`},
+		{2, `"r":8,"p":1`, `"r":4,"p":8`},
+		{2, `"dklen":32`, `"dklen":1073741824`},
+		{2, `"dklen":32`, `"dklen":16`},
+		{0, `"c":262144`, `"c":1073741824`},
+		{0, `"c":262144`, `"c":0`},
+	}
+	for _, test := range tests {
+		data := strings.Replace(vectors[test.vector].json, test.from, test.to, 1)
+		if _, _, err := Decrypt([]byte(data), vectors[test.vector].password); err != ErrKDF {
+			t.Errorf("%s: %v, want %v", test.to, err, ErrKDF)
+		}
+	}
+}
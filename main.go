@@ -6,47 +6,304 @@ package main
 
 import (
 	"fmt"
+	"log"
 	"os"
 	"strings"
 
-	"github.com/btcsuite/btcnet"
-	flag "github.com/btcsuite/go-flags"
+	flag "github.com/jessevdk/go-flags"
+	"github.com/kargakis/cryptowallet/bip38"
+	"github.com/kargakis/cryptowallet/paper"
+	"github.com/kargakis/cryptowallet/wallet"
 )
 
-var netParams = &btcnet.Params{}
+// network holds the parameters of the selected coin and network.
+var network *wallet.Network
+
+// layout places the generated paper wallets on pages.
+var layout *paper.Layout
 
 func init() {
 	_, err := flag.Parse(conf)
 	debug(err, "Error while parsing flags")
 
+	if conf.Coins != "" {
+		err = wallet.LoadCoinsFile(conf.Coins)
+		debug(err, "Cannot load coins from "+conf.Coins)
+	}
+
 	if conf.Support {
 		fmt.Println("Supported cryptocurrencies")
-		for coin := range coinID {
-			fmt.Println(strings.ToUpper(coin))
+		for _, coin := range wallet.Coins() {
+			name, _ := wallet.CoinName(coin)
+			fmt.Printf("%-6s %s\n", strings.ToUpper(coin), name)
 		}
 		os.Exit(0)
 	}
 
-	if id, supported := coinID[strings.ToLower(conf.CoinType)]; supported {
-		if conf.Testnet {
-			netParams.PubKeyHashAddrID = id.isOnTestNet()
-		} else {
-			netParams.PubKeyHashAddrID = id.isOnMainNet()
-		}
-	} else {
+	network, err = wallet.NewNetwork(conf.CoinType, conf.Testnet)
+	if err == wallet.ErrUnsupportedCoin {
 		fmt.Println("Coin type " + conf.CoinType + " not supported!")
 		os.Exit(1)
 	}
-	netParams.PrivateKeyID = netParams.PubKeyHashAddrID + 128
+	debug(err, "Cannot load network parameters")
+
+	layout, err = paper.LayoutByName(conf.Layout)
+	if err == paper.ErrUnknownLayout {
+		fmt.Println("Layout " + conf.Layout + " not supported! Available: " + strings.Join(paper.Layouts(), ", "))
+		os.Exit(1)
+	}
+	if conf.Layout != defaultLayout && (conf.Shares > 0 || conf.Multisig > 0) {
+		fmt.Println("--layout cannot be used with --shares or --multisig")
+		os.Exit(1)
+	}
+	if conf.Mnemonic && !layout.FitsMnemonic() {
+		fmt.Println("Layout " + conf.Layout + " has no room for a mnemonic")
+		os.Exit(1)
+	}
+	if conf.XPub && !layout.FitsXPub() {
+		fmt.Println("Layout " + conf.Layout + " has no room for an extended public key")
+		os.Exit(1)
+	}
+
+	if network.EVM {
+		checkEVMFlags()
+	} else if conf.Keystore || conf.ExportKeystore || conf.DecryptKeystore != "" {
+		fmt.Println("--keystore, --export-keystore and --decrypt-keystore require an EVM chain")
+		os.Exit(1)
+	}
+	if conf.KDF != defaultKDF && !conf.Keystore && !conf.ExportKeystore {
+		fmt.Println("--kdf requires --keystore or --export-keystore")
+		os.Exit(1)
+	}
+	if (conf.Path != "" || conf.XPub) && !conf.Mnemonic {
+		fmt.Println("--path and --xpub require --mnemonic")
+		os.Exit(1)
+	}
+	if conf.RedeemScript && wallet.AddressType(conf.AddressType) != wallet.P2SHP2WPKH {
+		fmt.Println("--redeem-script requires --address " + string(wallet.P2SHP2WPKH))
+		os.Exit(1)
+	}
+	if conf.BIP38 && conf.Mnemonic {
+		fmt.Println("--bip38 cannot be used with --mnemonic")
+		os.Exit(1)
+	}
+	if conf.IntermediateCode != "" && (conf.Mnemonic || conf.BIP38) {
+		fmt.Println("--intermediate-code cannot be used with --mnemonic or --bip38")
+		os.Exit(1)
+	}
+	if conf.Lot > bip38.MaxLot || conf.Sequence < 0 || conf.Sequence > bip38.MaxSequence {
+		fmt.Println("Lot or sequence number out of range")
+		os.Exit(1)
+	}
+	if conf.Uncompressed && conf.Mnemonic {
+		fmt.Println("--uncompressed cannot be used with --mnemonic")
+		os.Exit(1)
+	}
+	if conf.Count < 1 {
+		fmt.Println("--count must be at least 1")
+		os.Exit(1)
+	}
+	if conf.Separate && conf.DumpString {
+		fmt.Println("--separate cannot be used with --dump")
+		os.Exit(1)
+	}
+	if conf.Dice && (conf.Count > 1 || conf.IntermediateCode != "") {
+		fmt.Println("--dice cannot be used with --count or --intermediate-code")
+		os.Exit(1)
+	}
+	if conf.Vanity != "" && (conf.Mnemonic || conf.Dice || conf.IntermediateCode != "") {
+		fmt.Println("--vanity cannot be used with --mnemonic, --dice or --intermediate-code")
+		os.Exit(1)
+	}
+	if conf.VanityPubKey != "" && conf.Vanity == "" {
+		fmt.Println("--vanity-pubkey requires --vanity")
+		os.Exit(1)
+	}
+	if conf.Combine != "" && conf.Expect == "" {
+		fmt.Println("--combine requires the --expect address")
+		os.Exit(1)
+	}
+	if conf.Shares > 0 || conf.Threshold > 0 {
+		if conf.Threshold < 2 || conf.Threshold > conf.Shares || conf.Shares > maxShares {
+			fmt.Printf("--threshold must be between 2 and --shares, at most %d\n", maxShares)
+			os.Exit(1)
+		}
+		if conf.Count > 1 || conf.Separate || conf.Manifest != "" || conf.Mnemonic || conf.BIP38 || conf.IntermediateCode != "" {
+			fmt.Println("--shares cannot be used with --count, --separate, --manifest, --mnemonic, --bip38 or --intermediate-code")
+			os.Exit(1)
+		}
+	}
+	if conf.DiceMix && !conf.Dice {
+		fmt.Println("--dice-mix requires --dice")
+		os.Exit(1)
+	}
+	if conf.Passphrase && !conf.Mnemonic && conf.Shares == 0 && !conf.Recover {
+		fmt.Println("--passphrase requires --mnemonic, --shares or --recover")
+		os.Exit(1)
+	}
+	if conf.Sides < 2 {
+		fmt.Println("--sides must be at least 2")
+		os.Exit(1)
+	}
+	if conf.ManifestPrivate && conf.Manifest == "" {
+		fmt.Println("--manifest-private requires --manifest")
+		os.Exit(1)
+	}
+	if conf.Multisig > 0 {
+		checkMultisigFlags()
+	} else {
+		if conf.Cosigners > 0 || len(conf.PubKeys) > 0 {
+			fmt.Println("--cosigners and --pubkey require --multisig")
+			os.Exit(1)
+		}
+		switch wallet.CheckAddressType(network, wallet.AddressType(conf.AddressType), !conf.Uncompressed) {
+		case nil:
+		case wallet.ErrNoSegwit:
+			fmt.Println("Coin type " + conf.CoinType + " does not support segwit addresses!")
+			os.Exit(1)
+		case wallet.ErrNoTaproot:
+			fmt.Println("Coin type " + conf.CoinType + " does not support taproot addresses!")
+			os.Exit(1)
+		case wallet.ErrUncompressedSegwit:
+			fmt.Println("Segwit addresses require compressed public keys")
+			os.Exit(1)
+		default:
+			fmt.Println("Address type " + conf.AddressType + " not supported!")
+			os.Exit(1)
+		}
+	}
+	if conf.Path == "" {
+		conf.Path = wallet.DefaultPath(network, wallet.AddressType(conf.AddressType))
+	}
 }
 
 func main() {
-	pk := NewPrivKey()
+	switch {
+	case conf.Decrypt != "":
+		decryptPrivKey(conf.Decrypt)
+		return
+	case conf.DecryptKeystore != "":
+		decryptKeystore(conf.DecryptKeystore)
+		return
+	case conf.Intermediate:
+		checkSystemEntropy()
+		newIntermediateCode()
+		return
+	case conf.Confirm != "":
+		verifyConfirmation(conf.Confirm)
+		return
+	case conf.Verify:
+		verifyPrivKeys()
+		return
+	case conf.Scan != "":
+		scanImage(conf.Scan)
+		return
+	case conf.SplitKey:
+		checkSystemEntropy()
+		newSplitKey()
+		return
+	case conf.VanityPubKey != "":
+		checkSystemEntropy()
+		searchPartialKey(keyOptions())
+		return
+	case conf.Combine != "":
+		combineSplitKey(keyOptions())
+		return
+	case conf.Recover:
+		recoverShares()
+		return
+	case conf.Multisig > 0:
+		checkSystemEntropy()
+		newMultisig()
+		return
+	}
+	files := walletFiles(conf.Count)
+	if !conf.DumpString {
+		for _, name := range files {
+			checkNotExist(name)
+		}
+	}
+	if conf.Manifest != "" {
+		checkNotExist(conf.Manifest)
+	}
+
+	checkSystemEntropy()
+	opts := keyOptions()
+	keys := make([]*wallet.PrivKey, conf.Count)
+	for i := range keys {
+		if conf.Vanity != "" {
+			keys[i] = vanityPrivKey(opts)
+			continue
+		}
+		var err error
+		keys[i], err = wallet.NewPrivKey(network, opts)
+		debug(err, "Cannot generate new private key")
+	}
+	if conf.Shares > 0 {
+		shareKey(keys[0])
+		return
+	}
+	if conf.ExportKeystore {
+		exportKeystores(keys)
+	}
 	if !conf.DumpString {
-		NewPaperWallet(pk)
+		if conf.Separate {
+			for i, pk := range keys {
+				newPaperWallet(files[i], []*wallet.PrivKey{pk})
+			}
+		} else {
+			newPaperWallet(walletFile, keys)
+		}
+		for _, pk := range keys {
+			if confirmation := pk.Confirmation(); confirmation != "" {
+				fmt.Println("Confirmation code:", confirmation)
+			}
+		}
 	} else {
-		fmt.Println(pk)
-		fmt.Println(NewAddress(pk.value))
+		for _, pk := range keys {
+			dumpPrivKey(pk)
+		}
+	}
+	if conf.Manifest != "" {
+		writeManifest(conf.Manifest, keys, files)
+	}
+}
+
+// dumpPrivKey prints pk and its address on the terminal.
+func dumpPrivKey(pk *wallet.PrivKey) {
+	fmt.Println(pk)
+	addr := pk.Address()
+	fmt.Println(addr)
+	if chain := chainLabel(); chain != "" {
+		fmt.Println("Chain:", chain)
+	}
+	if cashAddr := addr.CashAddr(); cashAddr != "" {
+		fmt.Println("CashAddr:", cashAddr)
+	}
+	if conf.RedeemScript {
+		fmt.Println(addr.RedeemScript())
+	}
+	if descriptor := pk.Descriptor(); descriptor != "" {
+		fmt.Println("Descriptor:", descriptor)
+	}
+	if mnemonic := pk.Mnemonic(); mnemonic != "" {
+		fmt.Println(mnemonic)
+	}
+	if conf.XPub {
+		fmt.Println(pk.XPub())
+	}
+	if conf.Keystore {
+		fmt.Println(pk.Keystore())
+	}
+	if confirmation := pk.Confirmation(); confirmation != "" {
+		fmt.Println("Confirmation code:", confirmation)
+	}
+}
+
+// debugf logs a message when debug logging is enabled.
+func debugf(format string, args ...interface{}) {
+	if conf.Debug {
+		log.Printf(format, args...)
 	}
 }
 
@@ -0,0 +1,97 @@
+// Copyright (C) 2014-15 Michail Kargakis
+// This source code is subject to the terms
+// of the MIT License
+
+package main
+
+import (
+	"encoding/hex"
+	"fmt"
+	"os"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/kargakis/cryptowallet/paper"
+	"github.com/kargakis/cryptowallet/wallet"
+)
+
+// checkMultisigFlags validates the flags of a multisig run. Without an
+// explicit multisig address type, segwit coins default to p2wsh and
+// the rest to p2sh.
+func checkMultisigFlags() {
+	if wallet.AddressType(conf.AddressType) == wallet.P2PKH {
+		conf.AddressType = string(wallet.P2SH)
+		if network.HRP != "" {
+			conf.AddressType = string(wallet.P2WSH)
+		}
+	}
+	switch wallet.CheckMultisigAddressType(network, wallet.AddressType(conf.AddressType)) {
+	case nil:
+	case wallet.ErrNoSegwit:
+		fmt.Println("Coin type " + conf.CoinType + " does not support segwit addresses!")
+		os.Exit(1)
+	default:
+		fmt.Println("Address type " + conf.AddressType + " not supported with --multisig!")
+		os.Exit(1)
+	}
+	keys := conf.Cosigners + len(conf.PubKeys)
+	if conf.Cosigners < 0 || conf.Multisig > keys || keys > wallet.MaxMultisigKeys {
+		fmt.Printf("--multisig must be at most the number of --cosigners and --pubkey keys, at most %d\n", wallet.MaxMultisigKeys)
+		os.Exit(1)
+	}
+	if conf.Uncompressed || conf.Mnemonic || conf.BIP38 || conf.IntermediateCode != "" || conf.Count > 1 || conf.Separate ||
+		conf.Manifest != "" || conf.Dice || conf.Vanity != "" || conf.Shares > 0 || conf.RedeemScript {
+		fmt.Println("--multisig cannot be used with --uncompressed, --mnemonic, --bip38, --intermediate-code, --count, --separate, --manifest, --dice, --vanity, --shares or --redeem-script")
+		os.Exit(1)
+	}
+}
+
+// newMultisig generates the keys of the cosigners, builds the multisig
+// address of all their public keys and prints a page per generated key
+// and a public page with the address, or dumps them on the terminal.
+func newMultisig() {
+	if !conf.DumpString {
+		checkNotExist(walletFile)
+	}
+	var keys []*wallet.PrivKey
+	var pubKeys []*btcec.PublicKey
+	opts := &wallet.Options{AddressType: wallet.P2PKH}
+	for i := 0; i < conf.Cosigners; i++ {
+		pk, err := wallet.NewPrivKey(network, opts)
+		debug(err, "Cannot generate new private key")
+		keys = append(keys, pk)
+		pubKeys = append(pubKeys, pk.ECPrivKey().PubKey())
+	}
+	for _, s := range conf.PubKeys {
+		b, err := hex.DecodeString(s)
+		debug(err, "Cannot decode public key "+s)
+		pub, err := btcec.ParsePubKey(b, btcec.S256())
+		debug(err, "Cannot parse public key "+s)
+		pubKeys = append(pubKeys, pub)
+	}
+	ms, err := wallet.NewMultisig(network, wallet.AddressType(conf.AddressType), conf.Multisig, pubKeys)
+	debug(err, "Cannot create multisig address")
+
+	if conf.DumpString {
+		fmt.Println(ms.Address())
+		if cashAddr := ms.Address().CashAddr(); cashAddr != "" {
+			fmt.Println("CashAddr:", cashAddr)
+		}
+		fmt.Println("Script:", ms.Script())
+		fmt.Println("Descriptor:", ms.Descriptor())
+		for i, pk := range keys {
+			fmt.Printf("Cosigner %d of %d: %s %x\n", i+1, len(pubKeys), pk, pk.ECPrivKey().PubKey().SerializeCompressed())
+		}
+		return
+	}
+	logo, err := paper.NetworkLogo(network)
+	debug(err, "Cannot load logo")
+	f, err := os.OpenFile(walletFile, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+	debug(err, "Cannot create "+walletFile)
+	if err := paper.WriteMultisig(f, ms, keys, &paper.Options{Logo: logo}); err != nil {
+		f.Close()
+		os.Remove(walletFile)
+		debug(err, "Cannot generate "+walletFile)
+	}
+	debug(f.Close(), "Cannot generate "+walletFile)
+	fmt.Println("Successfully generated " + walletFile)
+}
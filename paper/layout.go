@@ -0,0 +1,220 @@
+// Copyright (C) 2014-15 Michail Kargakis
+// This source code is subject to the terms
+// of the MIT License
+
+package paper
+
+import (
+	"errors"
+	"sort"
+)
+
+// ErrUnknownLayout is returned for layouts that are not built in.
+var ErrUnknownLayout = errors.New("paper: unknown layout")
+
+// Layout places paper wallets on pages. Sizes and positions are in
+// millimeters, positions of elements from the top left corner of
+// their wallet.
+type Layout struct {
+	// PageWidth and PageHeight are the size of a page in portrait.
+	PageWidth, PageHeight float64
+	// Wallets are laid out on a grid of Columns by Rows cells of
+	// Width by Height, the first with its top left corner at (Left,
+	// Top) and the rest GapX and GapY apart.
+	Columns, Rows int
+	Width, Height float64
+	Left, Top     float64
+	GapX, GapY    float64
+
+	PrivKeyQR, AddressQR, Logo                           Image
+	PrivKey, Address, Descriptor, RedeemScript, Mnemonic Text
+	XPub                                                 Text
+	// CashAddr is printed for coins with CashAddr addresses, which
+	// have no redeem scripts, so small layouts share their place.
+	CashAddr Text
+	// Keystore is printed for keys of EVM chains on request.
+	Keystore Text
+
+	// Folds are the distances from the top of the page of lines to
+	// fold it along.
+	Folds []float64
+}
+
+// Image is where a QR code or the logo is printed. It is not printed
+// when Size is zero.
+type Image struct {
+	X, Y, Size float64
+}
+
+// Text is where a text is printed, centered in Width and wrapped in
+// lines of LineHeight. It is not printed when Width is zero.
+type Text struct {
+	X, Y, Width, LineHeight, FontSize float64
+}
+
+// FitsMnemonic reports whether l has room for mnemonics.
+func (l *Layout) FitsMnemonic() bool {
+	return l.Mnemonic.Width > 0
+}
+
+// FitsXPub reports whether l has room for extended public keys.
+func (l *Layout) FitsXPub() bool {
+	return l.XPub.Width > 0
+}
+
+// FitsKeystore reports whether l has room for keystores.
+func (l *Layout) FitsKeystore() bool {
+	return l.Keystore.Width > 0
+}
+
+// PerPage returns the number of wallets on a page.
+func (l *Layout) PerPage() int {
+	return l.Columns * l.Rows
+}
+
+// origin returns the top left corner of the wallet in cell i of a
+// page. Cells are filled row by row.
+func (l *Layout) origin(i int) (x, y float64) {
+	col, row := i%l.Columns, i/l.Columns
+	return l.Left + float64(col)*(l.Width+l.GapX), l.Top + float64(row)*(l.Height+l.GapY)
+}
+
+// singlePage returns a layout of one wallet per page of the given size.
+func singlePage(width, height float64) Layout {
+	return Layout{
+		PageWidth:  width,
+		PageHeight: height,
+		Columns:    1,
+		Rows:       1,
+		Width:      width,
+		Height:     height,
+	}
+}
+
+// A4 is the default layout, one wallet per A4 page.
+var A4 = func() *Layout {
+	l := singlePage(210, 297)
+	l.PrivKey = Text{10, 17, 190, 6, 10}
+	l.PrivKeyQR = Image{80, 25, 50}
+	l.Logo = Image{90, 90, 100}
+	l.Address = Text{10, 136, 190, 6, 10}
+	l.CashAddr = Text{10, 142, 190, 6, 10}
+	l.AddressQR = Image{80, 150, 50}
+	l.Descriptor = Text{10, 203, 190, 5, 8}
+	l.RedeemScript = Text{10, 209, 190, 6, 10}
+	l.Mnemonic = Text{10, 215, 190, 6, 10}
+	l.XPub = Text{10, 250, 190, 5, 8}
+	l.Keystore = Text{10, 264, 190, 3, 6}
+	return &l
+}()
+
+// layouts are the built-in layouts by name.
+var layouts = map[string]*Layout{
+	"a4": A4,
+
+	// One wallet per US Letter page.
+	"letter": func() *Layout {
+		l := singlePage(215.9, 279.4)
+		l.PrivKey = Text{13, 15, 190, 6, 10}
+		l.PrivKeyQR = Image{83, 22, 50}
+		l.Logo = Image{93, 80, 100}
+		l.Address = Text{13, 121, 190, 6, 10}
+		l.CashAddr = Text{13, 127, 190, 6, 10}
+		l.AddressQR = Image{83, 135, 50}
+		l.Descriptor = Text{13, 188, 190, 5, 8}
+		l.RedeemScript = Text{13, 194, 190, 6, 10}
+		l.Mnemonic = Text{13, 200, 190, 6, 10}
+		l.XPub = Text{13, 240, 190, 5, 8}
+		l.Keystore = Text{13, 254, 190, 3, 6}
+		return &l
+	}(),
+
+	// An A4 page folded in three, with the address on the top
+	// panel, the private key on the middle one and the mnemonic on
+	// the bottom one, so the secrets are inside once folded.
+	"trifold": func() *Layout {
+		l := singlePage(210, 297)
+		l.Address = Text{10, 9, 190, 6, 10}
+		l.CashAddr = Text{10, 15, 190, 6, 10}
+		l.AddressQR = Image{80, 25, 50}
+		l.Logo = Image{165, 10, 30}
+		l.PrivKey = Text{10, 107, 190, 6, 10}
+		l.PrivKeyQR = Image{80, 115, 50}
+		l.Descriptor = Text{10, 170, 190, 5, 8}
+		l.RedeemScript = Text{10, 183, 190, 6, 10}
+		l.Mnemonic = Text{10, 210, 190, 6, 10}
+		l.XPub = Text{10, 255, 190, 5, 8}
+		l.Keystore = Text{10, 269, 190, 3, 6}
+		l.Folds = []float64{99, 198}
+		return &l
+	}(),
+
+	// One wallet per ID-1 credit card sized page, for laminating.
+	"card": func() *Layout {
+		l := singlePage(85.6, 53.98)
+		l.PrivKeyQR = Image{4, 6, 30}
+		l.Logo = Image{38, 12, 16}
+		l.AddressQR = Image{57.6, 9, 24}
+		l.PrivKey = Text{2, 38, 81.6, 3, 5}
+		l.Address = Text{2, 41.5, 81.6, 3, 5}
+		l.Descriptor = Text{2, 45, 81.6, 3, 4}
+		l.RedeemScript = Text{2, 48.5, 81.6, 3, 4}
+		l.CashAddr = l.RedeemScript
+		return &l
+	}(),
+
+	// One wallet per US business card sized page.
+	"business-card": func() *Layout {
+		l := singlePage(88.9, 50.8)
+		l.PrivKeyQR = Image{4, 4, 28}
+		l.Logo = Image{38.45, 10, 12}
+		l.AddressQR = Image{60.9, 6, 24}
+		l.PrivKey = Text{2, 35, 84.9, 3, 5}
+		l.Address = Text{2, 38, 84.9, 3, 5}
+		l.Descriptor = Text{2, 41, 84.9, 3, 4}
+		l.RedeemScript = Text{2, 44, 84.9, 3, 4}
+		l.CashAddr = l.RedeemScript
+		return &l
+	}(),
+
+	// Fourteen 99.1 x 38.1 labels in two columns on an A4 sticker
+	// sheet.
+	"stickers": {
+		PageWidth:    210,
+		PageHeight:   297,
+		Columns:      2,
+		Rows:         7,
+		Width:        99.1,
+		Height:       38.1,
+		Left:         4.65,
+		Top:          15.15,
+		GapX:         2.5,
+		PrivKeyQR:    Image{3, 3, 24},
+		Logo:         Image{43.55, 6, 12},
+		AddressQR:    Image{72.1, 3, 24},
+		PrivKey:      Text{2, 27, 95.1, 2.5, 5},
+		Address:      Text{2, 29.5, 95.1, 2.5, 5},
+		Descriptor:   Text{2, 32, 95.1, 2.5, 4},
+		RedeemScript: Text{2, 34.5, 95.1, 2.5, 4},
+		CashAddr:     Text{2, 34.5, 95.1, 2.5, 4},
+	},
+}
+
+// Layouts returns the names of the built-in layouts.
+func Layouts() []string {
+	var names []string
+	for name := range layouts {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// LayoutByName returns the built-in layout called name.
+func LayoutByName(name string) (*Layout, error) {
+	l, ok := layouts[name]
+	if !ok {
+		return nil, ErrUnknownLayout
+	}
+	return l, nil
+}
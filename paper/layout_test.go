@@ -0,0 +1,38 @@
+// Copyright (C) 2014-15 Michail Kargakis
+// This source code is subject to the terms
+// of the MIT License
+
+package paper
+
+import "testing"
+
+func TestLayouts(t *testing.T) {
+	tests := []struct {
+		name     string
+		perPage  int
+		mnemonic bool
+	}{
+		{"a4", 1, true},
+		{"letter", 1, true},
+		{"trifold", 1, true},
+		{"card", 1, false},
+		{"business-card", 1, false},
+		{"stickers", 14, false},
+	}
+	for _, test := range tests {
+		l, err := LayoutByName(test.name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := l.PerPage(); got != test.perPage {
+			t.Errorf("%s: %d wallets per page, want %d", test.name, got, test.perPage)
+		}
+		// Extended public keys are printed along with mnemonics.
+		if l.FitsMnemonic() != test.mnemonic || l.FitsXPub() != test.mnemonic {
+			t.Errorf("%s: room for mnemonics %v and xpubs %v, want %v", test.name, l.FitsMnemonic(), l.FitsXPub(), test.mnemonic)
+		}
+	}
+	if _, err := LayoutByName("a3"); err != ErrUnknownLayout {
+		t.Errorf("a3: %v, want %v", err, ErrUnknownLayout)
+	}
+}
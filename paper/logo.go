@@ -2,14 +2,17 @@
 // This source code is subject to the terms
 // of the MIT License
 
-package main
+package paper
 
 import (
 	"bytes"
 	"compress/gzip"
 	"fmt"
 	"io"
+	"io/ioutil"
 	"strings"
+
+	"github.com/kargakis/cryptowallet/wallet"
 )
 
 // Coin logos in bytes automatically generated
@@ -20,40 +23,52 @@ var (
 	drkLogoBytes = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xec\xfb\x05\x50\x1c\xdf\xda\xf6\x0b\x0f\xae\x41\x43\xb0\xe0\x10\x9c\xc1\xdd\x5d\x82\x04\x08\xee\x30\x38\x0c\xee\xee\x1a\xdc\xdd\x83\x07\x0f\x6e\xc1\x9d\xe0\x36\xc1\x02\xc1\xdd\xe5\xe3\xbf\xe5\xd9\xbe\xbf\xa7\xea\x7d\x4f\x9d\x3a\x55\xe9\xaa\x99\x9e\x5e\x6b\x5d\xd7\xaf\xfb\xee\x7b\xdd\xbd\x3a\x15\xc2\x95\x14\xa4\x5e\x21\x13\x20\x03\x00\x80\x57\x32\xd2\xe2\xca\x2f\xfb\xbb\x3f\x3e\x88\xf0\x2f\xdf\x61\x07\x5a\x02\x2f\x3b\x24\x3b\x69\x4d\x47\x00\x00\x05\xfb\x8f\x0f\x14\x20\x3d\x13\x0f\x00\x40\x56\xb4\x10\x13\x53\x52\x32\x07\x3b\x81\x1d\xcd\xc1\x76\xa4\x32\x62\x62\xa4\x76\x0e\x60\x53\x0b\x6b\x10\x00\xe0\xb6\x94\xa5\x62\xa6\xaa\xf2\xeb\x8d\xc0\xf5\xda\x85\xa8\x5c\x98\x5f\xa2\x1c\x58\x19\x07\x91\x54\x59\x34\xd2\xef\x75\xdc\x3b\x3a\x32\x24\x0c\xd9\x30\xb2\xbc\x45\x9c\x0f\xdd\x98\x12\x12\xb0\x84\x83\xb9\x61\x30\x9f\x3e\xf9\x45\xe1\x7c\x60\x40\x8d\x40\x5e\x40\xd8\x22\xcb\xff\x14\x58\x10\x16\x31\x7c\xbf\xee\x59\x60\x35\xd7\x76\xfd\x73\xec\xa9\x61\x5e\x70\xa7\xe2\x3c\xab\x6e\x02\xa1\x0b\x11\x35\x59\x9a\xed\x03\x87\x1f\x6a\xa9\x28\x31\xe6\x8f\xc0\x81\x6f\xfd\xeb\x5b\x4c\xc2\xfe\xc8\x94\x76\x00\x0c\xc4\x3a\x13\x32\xc7\x47\x0a\x28\xc0\x8d\x0f\x3f\x3f\x3d\x79\x17\x42\x07\x00\xca\xed\x3b\x0a\x22\xa0\x4b\x3e\xa3\x8b\xf9\x6d\xe8\x33\xfa\x9e\x68\x9a\x0e\x94\x5f\x10\x54\x97\x53\x1c\xb7\x1c\xa2\xdf\x6b\x80\xb0\xc7\x27\xd1\x62\x80\xb0\x24\x94\x5f\x56\xea\x3b\x15\x40\x2e\x2c\xc0\x60\xc4\xd8\x68\x13\xa0\xc4\x08\x30\xa0\xf6\xfd\xf9\x1d\xe0\x97\x75\x93\xe6\x09\x05\xd0\x4e\x24\xc3\x81\xca\x8d\x03\x90\x62\x83\xc2\xc4\x01\xe6\x7c\x80\x8a\x09\xb5\x08\x09\x80\x06\x33\xe0\xb5\xa9\xdc\x37\x1e\xc0\x02\x23\x80\x59\x46\xdd\x54\x1a\xf0\xa5\x09\xd0\x35\x8e\x81\x52\x0b\x40\x44\x05\x30\x7f\x08\x0b\xa6\x05\xc0\x7a\x02\x0c\x06\x29\x28\xdc\x00\x41\x69\x80\xd7\x92\x67\xea\xfc\xa7\xf4\x55\x18\x3b\x74\x2f\x91\x4a\xab\xe5\x6f\xa6\xe4\x91\xf0\xd7\x46\x64\xb4\x47\x50\x57\x67\x22\xa6\x19\x97\xc1\xa5\x7f\xcd\x69\x08\x6d\x98\x2e\xcc\xd4\xe3\x9e\x86\xcf\xea\xcf\x8e\xfe\xe3\x7c\x14\x00\xc8\x8d\xc3\x79\xb9\xda\xf3\x47\xb7\x71\xb4\xaa\xf1\x71\xce\x4f\x8d\x68\xfa\x8c\x7b\x9d\xf0\xcf\xe4\x46\x46\x9b\x4f\xdb\x13\xe5\x76\xc2\x00\xc0\xba\x93\xcf\xd4\x13\x03\xb0\x8e\xc0\x8f\x0f\xde\xcf\xe6\x29\x9f\xea\x08\xd6\x5c\x0f\x25\xd7\xf9\x3a\xd2\xb4\x16\x5d\xb8\x01\x90\xfb\x6b\x49\xfd\xdc\xee\x8f\xd8\x48\xe4\xc5\x36\x2f\x2e\x6e\x6f\x6d\x2d\xc8\x7e\x13\xd5\x36\x1c\x56\xf7\x7e\x32\xeb\xd1\xef\x52\x7f\xb4\xbe\xf5\xe1\x7f\xb8\xbe\xfc\xd1\xb1\x41\xe9\xcf\xaa\xeb\x2f\x05\x7b\xf3\xa3\x67\xf4\xe2\x7d\xd1\xdb\x89\x78\xa4\x20\xa3\x95\x9f\x31\x52\x0f\x4d\x28\xcf\x0f\x44\xdf\xc9\xbf\xc9\x18\x35\x6b\xd0\xbd\xde\xfc\x80\x65\xff\x49\x3c\x3d\xea\x9c\xb5\x88\x3c\x5c\x34\x9e\xb6\x8f\x6a\xfa\xd9\x64\x03\xc2\x70\x0f\x23\x08\xe1\x00\x7c\xb1\x14\x84\xf2\x8c\x63\xfc\xac\x6b\x0c\x73\x25\x47\x8d\xa1\xe1\xd4\xb5\x71\x0d\x00\x9c\xb5\x82\xbb\x67\x68\x10\x61\xfc\xcc\x03\xd7\x27\x5c\x9f\x8f\x85\x1e\xa5\x72\xa9\x00\x7e\xa6\x32\xf1\xf6\x00\x80\x9e\x38\x25\x93\xea\x70\x9d\xd0\x00\x22\x00\x20\x9e\xeb\x4f\xf7\x45\x84\xf0\xa2\x1b\x8f\xa1\x0b\xfe\x6d\x37\xdc\x4a\x37\xca\x83\x01\x67\x90\x28\xe5\xb7\x1e\x51\x0c\x51\x64\x13\xbf\xb7\x0e\x06\xb4\x15\x01\xa2\x34\x21\x63\x5f\x88\xe8\x0c\x80\x33\xc2\x44\xec\x5d\x1f\xcc\x43\x12\xec\xd0\x98\x7a\x3e\x7e\x45\x13\xb0\x43\xbf\x33\x44\xcc\xee\xa6\x69\xf3\x47\x82\x15\x19\x44\xc4\x36\x24\x5b\x0a\x83\x32\x8a\xd0\xa0\xf8\x84\x88\x9b\x16\x7c\x43\xd6\x2d\x0d\x8b\x15\x49\x49\x3a\x14\xf6\xfa\x03\xb9\x5c\x1c\x87\x34\x8b\x72\x84\x2a\xe9\x47\x69\xa2\x33\x01\x07\x68\x9e\x50\x1d\xc9\x96\x38\xbe\x41\x00\x6b\xc0\x43\x48\x9b\x14\x4b\xa2\x7f\xed\x07\x81\x19\xd4\x06\xb1\xe6\xcf\x5c\x04\x41\x92\xf9\x28\x93\x26\x4e\x2c\xe9\x31\x6f\x72\x33\x26\x1d\xea\x09\x04\xa2\x38\xf3\x76\x26\xcb\xcd\xd1\x97\x62\x04\xc5\x06\x98\xbb\x76\xf1\x10\xd8\xbe\x59\x33\x33\xf5\xf7\x8a\xd6\x70\x33\x20\x25\x18\x8f\xd4\x90\x57\xa0\x96\xf7\x13\x7c\x51\xab\x80\x69\x0d\xbf\x34\x9e\xa8\x71\x85\xc5\xf2\x97\xe8\x42\xe2\x84\x75\x15\x25\x35\x24\x16\x91\xd6\x78\xaf\xa1\xb0\x20\xfd\x99\xf4\x2d\x25\x3b\xe6\x12\x12\x17\x12\x4c\xd0\x87\x1e\x6a\xf2\x84\xcf\x72\xf5\xef\x5a\x30\x6f\xbf\xe1\x19\xb1\xb2\x84\x6a\x48\x33\x50\xcc\x61\xc5\x60\x03\x31\x3c\xc3\xc4\xbe\x71\x51\x62\x85\x8b\xb1\x52\x37\xc7\x42\xcb\xd4\x14\xaa\x4e\x63\x4d\x4b\x4e\xdb\x2a\x53\xfc\x62\x28\xcd\x60\x97\x26\xa0\x48\x18\x5a\x32\xb1\xaa\x45\xe0\x49\x64\x20\xa5\x1f\x0c\xde\xca\xda\xea\xdd\x62\xdb\x22\xdc\x22\x3b\xd3\x46\xce\x32\x12\xb6\x2f\xbc\x51\xd9\xa0\x51\x1e\xb6\x94\xc5\xdf\xc6\xd8\x66\xdf\x86\x07\xa5\xb2\x8a\x7c\x2c\x48\x57\x5e\xfd\x9c\xa7\x8a\xc9\x11\xa3\x25\x5a\x99\xd7\xa8\x5c\xfd\x21\xf4\x33\x36\xbb\x73\x3a\xcf\x58\xe0\x1b\x19\xb9\xa2\xca\xfc\xa4\xef\x04\xe6\xb8\xe6\xd7\x16\xd5\xbb\x4e\x2d\x98\xab\x49\xc4\x0a\x29\x93\xea\xd3\x23\xbf\xa4\x76\x53\x76\x61\x2e\x5c\x51\x5f\x85\xe0\x04\x0e\x84\x99\xd1\xe0\x67\xe0\x21\xe0\x99\xe1\xbb\xe2\x55\xa7\xbe\x2f\xc6\xff\x1e\x89\x3f\xa0\xca\xcc\xc5\xb2\x9f\xba\x93\x86\x9e\x06\xfe\xa8\x41\xd7\x48\xe7\x85\x13\x37\x8c\x9c\x24\x9b\xf4\x2e\x89\x90\x0e\xa8\x5a\x5a\x56\x5c\xb6\x56\x86\xf2\xf1\xfc\xe3\x88\x6a\x4c\xa9\x9e\x8a\x77\x95\xe4\x47\x36\x95\xcd\x52\xa4\x99\xe4\xf2\x8f\x65\x59\x4a\xfb\x2a\x84\x2a\x66\xa5\x7c\x9f\x43\xcb\xcc\x3e\x83\x55\x85\x0a\x7d\x34\x1f\xc3\xe1\x35\x7b\xdf\xf7\x2a\x8a\x15\x7e\xc8\xad\xd1\xdf\x6c\xeb\x27\x45\x31\xc6\xaf\x73\x63\x20\xb3\x7a\x55\x1e\xe2\xec\xcf\xf1\xed\x5d\xcd\xd8\xa2\x97\x0b\x5d\x6b\xea\x23\x51\x6b\xa4\x40\x59\x69\x1e\x8f\x18\x9b\x2c\x9b\x7a\x9d\xf2\x79\xa2\xa9\x10\xc2\x66\xaa\xfd\x4d\xa6\x93\x02\xd2\x0e\x4d\x49\xca\xb9\x4f\xc4\xda\xeb\x63\xda\x0d\x5a\xc2\x62\xf6\xf7\xcd\x2c\x20\x1d\xab\xb2\xe4\x94\x64\x8d\x72\xe9\xf2\x0f\xe5\x52\xfb\xed\x9c\x55\x87\x25\x59\xc5\xed\x12\xad\x9a\xf7\xfe\x68\x22\x03\xb2\xd4\x3a\xd4\x52\x66\x31\xe3\x70\x59\x3b\x2a\x8b\x2a\xab\x25\xc8\x25\x6f\xe4\x05\x78\x2f\xab\x98\xca\xac\x8a\x08\xf3\x5a\xc5\x5d\x3b\x6d\x4b\x8e\x52\xb3\x3b\xf4\xfc\xb2\xdf\x83\x4b\xd6\xdc\xdb\x77\xee\xde\x7a\x03\x1f\x55\x1f\x6d\xee\x4a\x2e\xa5\x50\x35\xe0\xeb\x50\x29\xe1\x97\x51\xc7\xf1\xf8\xfb\x38\xec\xc1\xfc\x44\xc0\x81\xe5\xd3\x0f\x62\xba\xbd\xc2\x62\x45\x86\x49\x86\x29\xeb\x41\x71\xcd\x53\xfc\x05\xb3\x92\xd1\x92\xe8\x51\x16\xfd\x3a\x9b\x94\x9b\x36\xfd\x36\xfd\x05\xef\xe0\xdf\x91\xbd\x93\x93\xdf\x93\x3f\x4a\x57\x96\x6f\x2e\xa6\x2d\xe6\x7b\xcf\xf7\x7e\x60\x6c\x74\x2c\x7f\x6c\x2e\x8d\x23\x43\x80\x65\x9e\x75\x27\x63\x27\x63\x3e\x03\xd2\x64\xa0\xe5\xa6\xc5\xd0\xf8\xb9\xf1\x2b\x48\xa6\x76\x4b\xab\x58\xf3\xa8\xb1\xcd\x36\x58\x8b\x47\xb3\x48\xe3\xa3\x96\x6e\x9d\x64\x65\x56\x25\xd5\x5c\x6b\x65\x6b\x09\x66\x49\xb3\x42\x82\x42\x79\xf6\x9c\x59\xef\x97\xd3\xc6\xa1\xc6\xd2\x26\xfc\x2f\xdb\x0b\xf0\xcb\x55\x8d\x36\x8d\xde\x86\x66\x46\xb8\x66\x4d\x7d\x55\xbd\x47\x09\xed\x09\xde\x2d\xde\xd1\x8f\x02\x08\xaf\x42\x17\xd1\xca\x89\xb4\x88\x7c\x1c\xeb\x1d\x5e\xf3\xaa\xf1\xae\xa5\x8f\x67\x1e\x09\x79\xf7\x5d\x24\xff\x02\x9a\x69\x4c\x60\x4c\x2c\xb0\x6d\x36\x62\x8f\xd0\x7e\xb3\xc8\x52\xc9\xf8\x2e\x65\x86\x6b\xd6\xec\x18\x78\x1e\x16\x19\x2e\x17\x30\xc2\x94\x22\xc8\x94\x41\xd8\xbf\x3a\xd8\xbe\x25\xeb\x74\x69\xaf\xbb\xee\x31\x7d\x99\x95\x51\x97\xd1\x73\xf8\x79\xb9\xfa\x88\xf3\xc8\xe2\x28\x74\x25\x67\x94\x3d\x87\x36\x5b\x93\xcd\x71\xb2\x77\x50\xec\xc7\x87\xed\x29\x21\x94\x9c\x24\x66\x2b\x48\x85\x8f\xa2\x98\xa2\xbf\x59\xc3\x0e\x05\xd0\x18\x18\xa7\x5e\x39\xff\xa5\xa9\xc8\xb5\xec\xda\xf0\x18\x57\xf0\x4a\xd0\x21\x67\x53\x70\x93\x9f\x6b\x8d\x75\x4d\x53\x7f\x94\xab\xab\x69\xb0\x69\x44\xb1\x17\x9c\xad\x1f\x0c\x11\xf3\xa5\x78\x36\x79\xf6\x78\x9e\x02\x94\xf8\x51\x41\x99\xc1\x84\xfb\x8b\xf8\x6b\x43\xdf\xdf\x78\x78\x91\x75\x7e\x12\xe2\x5d\x06\x5d\xd5\x2e\x1e\x8a\x44\xe5\x36\x8b\xe0\x88\xc4\x04\x54\x06\x6c\x77\x57\x92\x99\x0f\x1e\xc4\x56\x92\x5d\xef\xa4\x8e\xc7\x8d\x5b\xa0\x05\x2b\xf9\xec\xce\x4d\x22\x18\xfe\x34\x8c\xec\xf5\x41\xa5\x0e\x0e\x0d\x56\x0f\x11\xfa\x64\x17\xa6\x41\x30\xc5\x92\xc2\xe1\x3a\xb5\x22\xe6\x08\x11\xbf\x13\x4f\x16\x5f\xcb\x30\x63\x51\xe4\xa9\x66\x94\xe5\xa0\x13\x2c\x63\xb2\xe4\x5f\xe2\x6a\x61\x6f\x11\xea\x67\x32\xd5\x1c\x9d\xd5\xc8\xd2\x90\xb1\x96\x7b\xcb\xc3\x44\x57\xc5\x69\x6b\xdd\x01\x79\x38\x3c\x0f\xdd\xc8\xda\x40\xd9\xf4\xc4\x4d\x05\x0e\xea\xda\xb9\xe7\x8d\xf7\x5c\x04\x97\xbc\x29\x65\xc2\x62\xe0\x4d\x16\x7e\x1f\xa6\xc0\x9d\x78\x22\x82\x41\xf7\xf9\x4d\x14\xd5\x19\xb6\x2b\xd5\x27\x64\xf7\x1e\xa2\x1e\x33\x72\xe0\x14\xe7\x54\xd8\x82\x98\x0e\x1d\xb1\xc2\x99\x34\x9d\xbc\x47\xfc\x41\x5c\x1b\x87\x3b\x65\x1f\x5b\x11\xc3\x76\x73\x10\x75\x69\x6c\xdb\xfb\x57\xef\x57\x33\x78\xb3\xdf\xa4\xbb\x90\xda\xb0\x0a\xf0\xb6\xb3\x04\xc6\xc7\xc5\xda\x8b\x96\x91\xc9\x27\x34\x9a\xed\x99\xfd\x34\x75\x19\xa9\x00\x05\xd7\x3e\xf5\xd9\x46\x36\x01\x89\x93\x10\x86\xac\xea\x62\x2b\xe0\x34\x19\x91\x1b\x45\x8b\x77\x55\xb5\x68\xe6\xe9\x10\x6a\xcd\x40\xea\xa6\x9d\x63\xe1\xc3\x3c\xc9\xe5\xd9\xf4\x63\x87\xa3\x75\xc3\xcb\xb1\x5c\x99\xc7\x39\xcf\x87\x58\x3d\x58\xeb\xf1\x8d\x45\x21\x72\xea\x8c\x44\x1a\x3f\xdb\x2b\x2c\x79\x6a\xf9\xed\xdd\x97\x91\x37\x71\xe0\xe8\x43\xd4\xe0\x2b\xf8\xbf\x46\x34\xd2\xd9\xcc\x2a\x96\x1f\xaa\xa7\x54\xf9\xb4\xbf\xf3\x90\xb7\xf2\xd7\xeb\x1e\x0e\xe8\x6e\x80\xb2\xda\x47\xf9\x88\xbc\x1d\xec\xbd\xbf\x32\x7f\xa8\xab\xa8\x01\xd4\x84\x34\x18\xde\x85\x64\x11\x0a\xe2\x84\x47\x54\xbd\x5e\xc6\x4d\xc1\xf1\x5d\xa0\x59\x71\xdb\x26\x0c\x05\x0f\x77\xa6\xad\x24\xd6\x97\x96\x7f\x6b\x36\x99\x30\x9d\x36\xfd\xf5\x73\x8d\x31\x5b\x1b\x2d\xb9\x2c\xe5\x67\x0a\x9a\xed\xab\xc5\xa5\xfa\x57\x10\xb5\xfa\xc3\xcb\x2b\x89\x65\x60\xca\x18\xcd\x72\x6b\x60\xa3\x58\x73\x41\x27\xff\xd7\x36\x50\xc9\xd0\xf8\x18\x9f\x90\x6a\xa1\xea\x85\xea\x9d\x2a\xe7\xd1\xf4\x6a\x83\xe1\xfc\x8d\xed\xd4\x11\xa1\x67\xdb\x15\xfb\xc1\xec\xb2\x76\xdb\xf5\xa3\xe0\x50\xc7\xf2\x47\xa8\x8f\xf2\xb3\xa6\xb3\xa0\x7b\x99\xfb\xba\x6b\xa1\xaa\xf0\xb2\x5f\x37\xc9\xf7\x36\xba\x95\x0a\x36\xf6\x87\x03\x30\xd3\x30\x8f\x08\x14\x68\x8d\xf3\xcd\x33\xb3\x44\xd3\x02\xaa\xca\x31\x73\xf4\xfb\x52\x68\x03\x24\x1d\x4f\x59\xc7\xae\x8c\xaf\x38\x5f\x7d\x8c\xce\xec\xdf\xa0\xb0\x13\x77\xdf\xfa\x75\x8d\x04\xb9\x26\x7e\xc5\xbb\xe4\x12\xe5\x59\xb1\x8e\xf0\x9d\xc4\x85\xc8\x8c\x08\x68\x29\x9a\x7e\x96\x2e\x99\xa1\x92\x7e\xaf\x6d\xa4\xdd\xa2\x38\x2a\x38\x35\xb7\xb7\xe6\xf1\x20\xb5\x4c\x82\x9e\xc9\xce\xaf\xe9\x2e\x7b\xae\x3a\x64\xb1\xf4\x2b\xe0\x7b\x82\xd9\xd9\x44\x54\x56\x52\x96\xb7\x0f\xd3\xed\x8a\xc1\x72\x7e\xa3\xe7\x8e\xf1\x38\xc4\x12\x1d\x7c\xdc\x82\xe1\xb4\x3e\x2d\xf1\x1c\xb9\xc3\xd7\xc6\x37\xae\x73\xf6\xd5\xe9\xac\x76\x79\x1b\x5b\xfd\xab\x7a\x7a\x35\xaf\xbe\xcd\x9e\xd3\x1e\xff\x53\xc2\x77\xc5\x81\xd6\xcc\xd6\xf4\xaa\x66\x1d\xab\xe6\x23\x8f\xf6\x06\x4f\xd3\xeb\x5f\x84\xd9\x5c\xe3\xab\x17\xed\xee\xa7\x6f\x9e\xe7\x66\xb3\x79\x04\x56\xdb\xcf\x3d\xc0\xd7\x7d\xd7\x97\x90\x37\xab\x3e\x0e\xd4\x8f\xe3\x23\xad\x8b\xae\xd4\x47\x95\x6d\x8a\x6d\xfa\x17\xc2\x87\x22\x8b\x62\xab\xa1\xb3\x42\xda\x4f\x2b\x0b\xc7\x2b\xbe\x96\x5d\xae\xb9\xe7\x53\x2b\x52\xd7\xf0\xc1\x81\x13\x4f\x71\x97\x31\xe8\x62\x70\x31\x69\x31\x23\x21\xaf\x42\xf6\xb3\xb9\x05\x79\x78\x36\x84\x1c\x1e\x7f\x3d\x94\x88\x4d\x98\x4c\xbc\xcb\xc6\x7d\xca\x7f\x1a\xa9\xc2\x7d\x03\xf4\xb9\x99\xdd\x9f\x1f\x9f\x4a\x9d\xca\x4b\x39\x4b\x49\xe8\xb4\xf1\x2a\x7a\xdc\xeb\xda\xc7\xaf\x5e\xee\xef\xe9\x27\xee\x18\x72\x63\x63\xd1\xbb\x50\xbb\x3f\xec\x76\x5d\x71\x25\x3a\x2e\x80\xa0\x2b\x6e\x79\x91\xfa\xd2\x3c\x9b\xdf\xd6\x5c\xaa\x4c\x96\xdc\x27\xe0\x27\x24\x3c\xc3\xc2\xa4\x9e\x3f\x18\xb3\x31\x2e\x03\x00\xbc\x84\x16\xaa\x1a\x4e\x1a\xf2\xef\x79\x8d\xc1\x36\x4c\x86\x26\x60\x23\x10\x93\x9b\x8d\x1d\xe0\x8f\x8d\x5f\xc8\xcd\xce\xd0\xd8\x0a\xe4\x44\x6a\x04\x32\xb3\xb0\x15\x20\x3f\x6e\xed\x24\x27\xb5\x30\x11\x20\x57\xe7\x90\x67\x96\xb7\x13\x03\x99\x5b\x48\x7b\x38\x80\x54\x3c\x14\x54\x8d\x3d\xac\x8c\x79\x4c\xc8\x85\x04\x91\xf9\xdd\x78\x5f\x0c\x6c\x40\x4e\x86\xa4\x6e\x36\xd6\xb6\x8e\xbc\x6e\x02\xe4\x7f\xf2\xe5\x7d\xf9\xfd\x47\x33\x90\x9c\xf4\x4f\x43\x9c\xac\x04\xc8\x45\xfe\xe8\x20\xd5\x90\x57\x22\x15\x03\x3b\x80\x48\x39\x98\x38\x18\x8d\x99\x59\xd8\x49\xb9\x78\x98\x58\x38\x58\xd8\xb9\x59\x18\x48\x59\x99\x59\xd8\x80\xcc\x6c\x40\x16\x36\x46\x16\x56\x5e\x66\x1e\x5e\x16\x0e\xd2\xbf\x6c\xe4\x82\xc8\x2f\xdf\xfc\x0e\x26\xa6\xbc\xca\xe2\x92\x7f\xc1\xbd\x1c\x09\x90\x9b\x3b\x39\xd9\xf1\x02\x81\xae\xae\xae\x4c\xae\x6c\x4c\x60\x07\x33\x20\x0b\x0f\x0f\x0f\x90\x99\x15\xc8\xca\xca\xf8\x32\x82\xd1\xd1\xdd\xd6\xc9\xd0\x8d\xd1\xd6\x91\xe2\xcf\x26\x7f\xf5\x11\x07\x39\x1a\x3b\x58\xd8\x39\x59\x80\x6d\x49\xff\x38\x36\x34\x02\x3b\x3b\x09\x90\x93\x23\x93\xfe\xdd\xf6\x97\xeb\xb2\xb1\xfb\x1f\x90\xad\xe3\x5f\x62\xf7\x12\x45\xa0\x9b\xa1\x1d\x90\x85\x89\x19\xf8\x1f\x44\xf2\xf2\xff\x5d\x66\x63\xf3\x6f\x95\x8e\x4e\x12\x2e\x4e\xff\x5d\xe9\xa8\xea\x6e\x07\x02\x2a\x83\x1c\xc1\xce\x0e\xc6\x20\x09\x17\x90\xad\x13\xc5\xbf\xb3\x32\x31\xfe\x1f\x1f\x3b\x67\x07\xeb\x3f\xc5\xc7\xc4\x18\x08\xb2\x06\xd9\xbc\x48\x1c\x5f\xbc\x58\xfe\xed\x29\xd8\xfd\xf5\x0d\xe4\xdf\x9f\xc6\xff\x74\xff\xc7\xab\x77\xb2\x30\x35\xfd\xf7\xda\x3f\x7a\xfe\xa3\x0c\xe4\x66\xf1\x1f\x64\x7f\xf4\xfc\x59\x26\xf8\x37\x1d\xff\x4b\x90\x79\xc5\x1c\x40\x86\x4e\x60\x07\x55\x30\xd8\x5a\xf0\xcf\x59\xf6\xb7\xf7\xa7\x97\xd7\x27\x1a\x79\x43\x63\x0b\xdb\x3f\x8e\x69\xf9\x81\xff\x3c\xfe\xdf\x59\x81\xc4\x5f\x3e\x82\x2f\xc9\xc8\xce\xc8\xcc\xc9\xc8\xc2\xa9\xca\xc2\xcc\xcb\xca\xca\xcb\xc6\x4a\xcf\xcc\xcd\xcb\xcc\xfc\x77\x26\x7f\x1e\xf9\x4f\x1e\xf2\x2f\x89\x6f\x62\xe8\x64\xf8\xbf\x71\xf9\x87\xb1\xff\xec\x03\x36\xb1\x30\x75\xff\x5f\xb9\xfc\x6d\xe4\x3f\x7a\xc8\xcb\xf3\xca\xd8\x3a\x3a\x19\xda\x1a\x83\x64\xc4\x05\x5f\x1a\x98\x2c\x2c\x4c\x78\x59\x98\x39\x58\xb9\xd8\x39\xb8\x18\xb9\xb9\x4d\x0c\x19\xd9\xb9\x58\x99\x5f\x7e\x31\xb3\x30\x9a\xb0\x9b\xb2\x72\xb0\x30\x33\x1b\xb3\x72\xf3\xfc\xc9\xf8\x1f\xe5\xff\x62\x2d\x0e\x36\x76\xfe\x23\x8b\xfe\x62\x6d\xf2\x62\xcd\x69\xc4\xca\x6c\x6c\x64\xcc\xc3\xc8\xc6\xcc\x61\xcc\xc8\xce\xc1\x62\xc8\xc8\xc3\xce\x03\x62\xe4\x60\x35\x64\x03\x71\x70\x1b\x19\x19\x19\x82\xfe\x6a\xfd\x77\xf2\x7f\xb1\x56\x74\xb0\x78\x29\x43\x86\xd6\xff\x87\x88\x7f\x63\xf3\x2f\x28\x69\x0b\xc7\x97\x64\x70\x17\xfc\x87\x64\xfc\x53\x81\x50\x01\xd9\xff\x63\xeb\x5f\x3b\xac\x2d\xfe\x54\x30\xec\x0c\x1d\x1c\x41\x7f\xcc\x43\x01\xf2\xbf\x4e\x44\xf2\x7f\x11\xfc\xa1\xf9\xd3\x7c\xe6\x35\x34\xfe\xa3\xd4\x08\x1a\xff\x29\x71\x4c\xf8\x81\xff\xd0\xfa\x9f\x65\x16\xff\x7a\x03\xff\x77\x21\xf8\x17\xf9\x7f\x66\xb8\x9a\x83\x6c\xff\x5b\x92\xfd\xdd\xa8\xff\x6c\xe2\x08\x36\x75\x72\x35\x74\x00\x89\x98\xbd\x44\xfa\xff\xef\x44\xfc\x77\x9a\x7f\x09\x36\xf0\xcf\xd1\xfe\x7f\xe0\x2e\x38\x1a\xba\xfc\x9f\xdd\x83\xff\xdd\x24\xfa\xff\xfe\x3d\xf8\x9b\xb7\xb1\xb9\xa1\xad\x19\xc8\x44\x10\xf8\x57\xe1\x5f\x1b\xfe\x77\xb7\xed\xcf\xad\xff\x38\xa7\xfe\x3a\x4f\xff\x75\x0e\xf2\x9b\x18\xf3\x9a\x82\x1d\x6c\x0c\x9d\x04\x2d\x6c\x0c\xcd\x40\x40\x3b\x5b\x33\x7e\xe0\xdf\x1a\xff\x6e\xe4\xff\x3c\x8b\x78\xc5\xc0\xd6\x60\x87\x97\x62\x08\x12\x64\xe3\x07\xfe\xbb\xe6\x7f\xab\x92\x11\x13\x53\xfa\xf3\xbf\xb0\x09\x3a\x2a\x4b\x89\x92\xca\x48\x88\x71\xb2\xf0\x70\x72\x32\xb2\x32\xb1\xfc\xbd\xcd\xdf\x8d\xfb\x3b\x9f\x3f\x9e\x66\x7f\xd4\x99\x97\xd8\x19\xfe\x29\x8b\x5e\x34\xff\xd2\xf6\xcf\xe3\x35\xfe\xc8\x54\x6b\xe7\x3f\xf5\xbd\x64\xce\xcb\x06\x64\xf9\xe3\xfb\x2f\xd2\xbf\xef\xfe\x67\xa9\xe6\x7f\x97\x6a\xfe\x17\xe9\xdf\xba\x3e\xda\x5a\x38\x09\xb2\xfe\x45\xf2\x4f\xcd\x7f\xa7\xfa\xe3\x91\xfb\xe7\xe8\xa9\xbc\xac\x0e\x41\x7f\x5c\xda\x3f\x37\xfd\xf3\x68\x25\x0b\x37\x90\xb5\x86\xb8\xc5\x4b\xb5\x75\xfc\xe3\x1c\x58\x39\x98\xff\x22\xfa\xe7\x9e\x7f\xab\xd4\xfc\x8f\x4a\xcd\x7f\x51\xfe\x39\xa1\xfe\x6e\x15\xf7\xe7\x25\x22\xf0\x2f\x6b\xc4\x97\xe5\x29\xf0\x7f\xd6\xa7\xff\x2e\xad\xff\xef\x6f\xbf\x21\xbf\x21\xbf\x21\xbf\x21\xbf\x21\xbf\x21\xbf\x21\xbf\x21\xbf\x21\xbf\x21\xbf\x21\xbf\x21\xbf\x21\xbf\x21\xbf\x21\xbf\x21\xbf\x21\xbf\x21\xbf\x21\xbf\x21\xbf\x21\xbf\x21\xbf\x21\xbf\x21\xbf\x21\xbf\x21\xbf\x21\xbf\x21\xbf\x21\xbf\x21\xbf\x21\xbf\x21\xbf\x21\xbf\x21\xbf\x21\xbf\x21\xbf\x21\xbf\x21\xbf\x21\xbf\x21\xbf\x21\xbf\x21\xbf\x21\xbf\x21\xbf\x21\xbf\x21\xff\x97\x21\xc8\x7f\xfb\x8b\x4f\x90\xad\x89\x00\xb9\x2b\xb9\x90\x20\xb9\xb4\x66\x1e\x00\x00\x20\x35\x96\x56\x96\x07\x00\x3c\xa8\x00\x00\xbf\x40\x00\xe0\xf6\xf9\x65\xff\x0b\x00\x70\x66\x06\x00\xf6\x0c\x00\x00\xde\x54\x00\x00\x17\x1c\xaf\xdf\x27\x09\x00\xf0\x44\xcb\x88\x8b\xa8\xba\x2d\x1d\xb4\xab\x7f\x29\x9a\x77\xde\xb9\xd6\xf7\x3c\x06\x66\x7a\x39\xee\x2d\x37\x39\x02\x08\xc2\xa5\x29\xb1\x24\x22\x0a\x53\xc5\x3f\x24\xf3\x4c\x0f\x26\x17\x5b\x56\x9c\x71\xbf\x6f\x1e\x46\xbf\x6a\x1d\xae\xf6\x21\x9c\xff\x6a\xbd\x98\x8d\x9f\x56\xf4\x81\xee\x15\xed\x87\x37\xd8\x78\xc2\xb1\x88\xe2\xe4\x7e\xb6\x41\xf0\xbc\x6b\x6f\x27\x04\x4e\xe5\x3c\x8f\xb7\x43\x8f\x57\xd0\x0c\xb8\xa6\xe4\x57\x8e\x3c\x5b\x3c\x9f\x35\x4a\x94\x00\x60\x88\xe0\x0e\xdf\x7d\xa7\xfa\x82\x55\xb5\xc4\x28\x6a\x68\xaf\xbd\x27\xc5\x0a\x85\x1d\x77\xe6\x24\x11\x5a\x28\x76\x4b\xef\xba\x9d\xa8\x2b\xf7\x5b\x85\x5d\xb4\xd0\x5e\xa4\xd5\x75\x3b\xee\x3c\xbe\xb7\x68\xd4\x4e\xa1\xbd\xeb\x41\x47\xff\x74\xf0\x1f\x14\xff\x45\xfe\x3f\x8a\xff\x22\xff\x7f\x0f\x38\x25\xa6\x3b\x82\xfe\xb8\x35\xda\xaf\xd0\x39\x01\x05\xf8\x2e\x2b\x4b\x01\x47\x35\x8a\x61\x9f\x9c\xa4\x62\xd3\x13\x70\xdc\xe0\xa5\xe8\x1c\x5d\x43\x0c\x80\xee\x12\x37\x09\x37\x80\xc2\x60\x93\x33\xae\x60\x1d\x1d\xd6\xa2\x0f\x0b\x2b\xc2\x90\xc4\xb2\xc0\xd0\x6c\x82\xbd\x23\x6a\xa9\xca\x31\x35\x35\x32\x8d\xab\x86\xda\x28\x7f\xd4\xa1\xba\x98\xe9\x31\xe5\x7a\x8a\x4c\x99\x60\xc8\x4a\xd5\xdf\x57\x48\x7e\xfd\xda\x2f\x80\x5a\x09\x05\x07\x3e\x92\x38\x64\x18\xee\x6d\x2a\x5e\xff\xd1\x14\x14\xac\xec\x46\xeb\xc0\xc4\xa6\xe7\xc0\x84\x61\x3d\xa9\xfd\xf3\x05\xf3\x95\x22\xaa\xf1\xf1\x02\xe9\x92\x4c\x48\x09\x84\x1b\x0e\xfa\x64\x2b\x0e\x7f\x46\x96\xac\x1e\x44\x99\x06\x0f\x70\x53\xf6\x0b\x23\x0d\x27\x2d\x12\x2e\xc0\xce\xc3\xa1\xa2\x81\x13\x14\x85\xc0\x04\x92\x05\xfb\xbd\x56\x6f\x6c\x55\x3c\x22\xfa\xca\x23\x5a\xdd\x44\xe1\x26\x4b\x7e\x42\x19\x05\xc2\xb3\xaa\x3e\x3f\xf5\x65\x2f\x24\x65\x4d\x0e\x9d\x68\x1c\x15\xa3\xd7\x65\x23\x90\x1d\xae\xe0\xf0\x6f\x9c\x3b\x2f\x8c\x4a\x4c\x0c\x07\x95\x33\x31\xbd\xaa\x25\x65\x06\x40\xd7\x7d\x49\x0e\x86\xa3\xac\xc3\x01\xd4\x90\xd6\x06\x0c\x5e\xf8\xf4\xdb\x7b\x22\x11\x19\x20\x62\x30\x60\xea\x5e\xba\xbe\xa1\xfc\xbc\x18\x9e\x6f\x9a\x59\x85\x10\xaf\x05\x9d\x89\xdc\x25\x6d\x57\x16\x46\xa1\x98\x4b\x84\x58\x8f\x2c\xcb\x90\xec\xd5\x8c\x4f\x87\xa4\x8f\xcc\x6c\x27\xc7\x94\x6e\x85\x75\xb7\x21\x09\x93\x77\x9c\xa7\x83\x48\x1a\x3c\x48\xf2\x53\x9e\x52\xd5\x04\x6b\x2c\x38\xe5\xa7\x56\xe7\xe3\x81\xf5\xfd\x77\x38\x2a\x52\xf3\x11\x1f\xfd\x9d\x74\xfb\x69\x17\x3f\x52\x6b\x78\x8b\xef\xef\x7b\xf6\x02\xaa\x79\xe1\x57\x28\x49\x67\x73\xd5\xfa\xeb\xb3\x35\x18\x24\xe0\x1d\x0f\x47\xdb\xa6\xc3\x2e\x3d\x6b\x8c\x22\x1a\x34\x29\x0c\xb2\xec\x44\x8e\x53\xf6\xb2\xc1\xee\x79\xda\xe3\x95\x1e\xed\xd8\x9b\x5d\xdf\x98\x8b\x43\xca\x06\xa3\x86\xf4\x79\xaf\x7d\x7e\xc1\xa7\xbb\xe9\x23\x65\x59\xb6\xfa\x5c\x0b\xef\x50\x97\x3b\x13\xf3\x12\x9d\x3e\xca\xbf\x4a\x34\x39\xfe\x75\xc6\x81\xf8\x10\xb1\xdc\xa9\x7d\xd6\xd4\x00\x63\x37\x7b\x9c\x02\xf5\x4e\xda\x2d\x1b\x38\x44\xe1\x2f\x8a\x4c\x8c\xe9\x67\xc1\x0a\x22\x44\xea\xeb\xc0\xd4\x15\xaa\xcd\xc2\x3e\x9c\x03\xbf\x31\xdb\x84\xc7\x74\xf3\x6e\xaf\xc1\x44\xd4\xa3\x91\x65\xc0\xa5\x52\x50\xf5\x3a\x3d\x12\xee\xd6\x26\xcf\xd7\x40\x12\x35\x3d\xa8\x64\x74\xa2\x45\x94\x29\x9e\x92\x65\x45\x55\x97\xfd\xfc\x46\xac\x50\xab\x00\xc3\x0d\xaf\xe2\xb5\x30\xa9\xc6\xc8\x97\xb6\x86\x6e\x9b\xea\xbd\xe0\x5c\x86\x93\x9b\x95\x5e\x95\xd9\x4e\x95\xb9\x7e\xf6\x65\xb4\x89\xfa\x5b\xbf\x12\xfe\x24\xdf\x26\x85\x9a\x4f\xfb\x7a\x1f\x0d\x86\xd5\x15\xa0\x03\xc6\x52\x62\x4d\x42\x4c\xc6\xf2\xc6\x98\x0d\xd2\xf2\xf6\x57\xc5\xfa\xb5\x34\x1a\x3f\x93\xf7\x7d\x25\xf9\x66\x33\x78\x7b\xae\xdb\x66\xca\x39\xcd\x45\x68\xea\xc8\x34\x32\x9d\x64\x5b\xb6\x53\xe6\xaf\x75\x06\x3b\x2b\x40\xcb\xde\x1b\x00\xe8\x6d\x8a\x9f\x74\x2c\xa7\x23\x63\x0b\x74\x2c\x77\x1e\xad\xe4\x32\xa8\xe8\x8b\x2b\xc5\x7c\xbb\x2a\x00\x0f\x60\x9e\xeb\xd1\x5a\x4e\x58\xb9\xf2\xe1\x1f\x99\x1c\x5d\xf8\xac\x5a\xfe\x2b\x1b\xd3\x73\xe3\xc7\xd2\x4f\xb9\xb1\x2a\xe2\x05\x4a\x30\x3f\x24\x2d\x6b\x92\xa8\x33\xe4\x74\x27\x52\xaf\xa9\x6d\x66\xed\xbb\x84\x76\x9f\x28\xa9\x50\xa6\x11\x22\x8d\x35\xd6\x43\x72\xff\xd1\xad\x33\xb1\xca\xe5\xcf\x12\xe8\x56\x82\x8f\xdc\x7a\x65\xd2\x8e\xa6\x2d\xbc\x0f\xf5\xd4\xbf\xf0\x15\x23\x42\x0c\xcd\x31\x6c\xbe\x80\x3a\x8a\x82\x98\x3c\x3a\xdc\xa9\x65\x0c\x51\x03\xd3\x58\x9a\xd6\x60\x61\x06\xeb\x25\x1a\x5e\x1b\x58\x7e\x46\xd6\x97\x31\x37\x8e\xc3\x47\xb1\x72\xd7\xd6\xf1\x30\x65\x06\xc8\x53\x72\x0e\x58\x6e\xab\x93\x08\x31\x1b\xb6\x6a\x90\x78\xd9\xe6\xdd\x82\xca\x45\x07\x99\x01\x15\x72\xb9\x7c\x8d\x90\xfb\xce\xaf\x3e\x48\xef\xa1\xf1\x13\xc2\x18\xef\xb5\xea\xc0\x15\x55\x0b\xec\x62\xe1\x07\x47\x53\xf9\xbe\x89\x04\x6d\x9e\xb7\x4e\x9b\xd8\x62\x65\x1d\xcc\x9a\xaf\xc1\x47\x04\x65\x82\xda\xf4\x21\xe5\x69\xdb\x01\x35\x2b\x86\x5d\x57\xed\x93\x4d\x8d\x54\x8a\xb2\xb0\x74\xcf\x17\x97\x0a\x41\x97\x6a\xb8\xf6\x65\x35\x9e\xf5\x21\x0e\x78\xb9\x78\x6e\x93\xfd\xa2\xc8\x50\x64\xe6\xda\xd8\xae\xf6\xe9\x22\xb4\x3c\xbe\x4e\xc7\x55\x0d\x0d\x41\xd7\x99\x10\x73\x62\x2d\x0e\x0f\x7d\x53\x6f\xc5\x96\xcb\x5f\x5f\x91\x88\x01\xfe\x64\x88\x27\x94\x00\x19\x3f\x75\x40\x41\x18\x47\x6e\xed\x17\x53\x92\x5e\xcb\xc7\x1a\x91\x55\xdb\x15\xa1\x03\xef\xe7\x0f\xeb\xc1\xad\x49\xbc\x16\xd1\x20\xaa\x3e\x5a\xc5\xe1\xcb\xac\x61\xd4\x21\xa1\xc7\xef\x42\x8f\x93\x24\x1e\xdb\x2b\x04\xac\xc6\x98\x66\xc5\x4d\x23\x53\x70\xa7\x6c\x3b\x73\x6b\x01\x53\x07\xfd\xa9\xba\x56\xb9\x53\xe1\x2c\xaa\x8c\x06\x5f\x74\x00\x1f\x9e\xd8\x96\x8d\x56\x3d\x69\x01\xf0\x69\x5a\x3f\x2a\x3f\x25\xf6\xfe\x14\x65\xe3\x71\xea\x20\x7e\x15\xda\x81\x40\xa6\xf3\x18\x63\x8c\xe0\xed\x97\xfd\xa1\x0e\xc3\x30\x61\x76\x37\xad\xb1\xdd\xcc\xb9\xce\x5b\xa9\x38\xcb\xa9\x5e\x47\x83\xb0\x22\x51\xad\xa1\x21\x2c\xfe\x75\x29\xe7\x49\x52\x50\xfc\x59\xc0\x00\x43\x15\x79\x6c\x9a\xff\xc9\x4f\xcd\x8b\xf2\xe6\x73\x51\x2c\x69\x28\x4d\x53\xe6\xce\x29\xf1\x78\x0b\x55\x14\x32\xd9\xbc\x50\x85\x4c\xb2\xef\xf0\x0d\xca\x61\xca\xbb\x62\x84\xf7\x71\x8c\x6d\x20\xc9\x4c\x53\x3c\x86\x50\x33\x92\x13\xa9\x27\xcb\xe6\xa4\xa8\x06\xa3\x47\xa9\x57\x93\xd9\x33\xac\xfc\x0e\x23\xe3\xf6\x75\xa0\x71\x52\x40\x73\x16\x99\x06\xbe\x81\x84\xf1\xc5\x81\x25\x7f\x8b\xc6\xc4\xd1\x5a\x45\x78\xf7\xd8\xeb\xd6\xe3\x74\x56\xa1\x54\x3c\x86\x76\x8d\x93\x8f\xad\x44\x25\x8f\xbf\x5a\x7c\xa5\x51\x5f\x91\x21\xe6\x29\x21\x26\xc2\x62\xda\x59\x5a\xc0\x50\xe3\xce\x91\xc1\xad\xaf\x09\x24\xe0\xd1\xe0\x18\x44\x07\x2f\x7e\x42\x90\x0b\x72\xc9\x8f\x33\xf1\x38\xcf\x2b\xdd\x83\x58\x4a\x5e\xde\x3b\x0e\xf9\xb6\x13\xef\x4f\x9e\x67\x7e\x74\x3d\x60\x80\x8e\x0a\x00\xf4\x28\x09\x53\x24\x82\x5d\x6f\x13\x8e\xdb\xeb\x1e\x4e\x8b\x24\x3d\xaf\x8f\x05\xbd\x3e\x13\x7b\x9c\xcd\x6a\x0f\x3b\x77\xa6\x27\x99\xc1\x4f\xbf\xa7\x18\x67\xaf\xaf\x09\x63\x7b\x35\x12\x25\xcb\x22\x5e\x84\xe1\x17\xd6\x65\xa0\xd1\xd1\x11\xc8\x0d\x0d\x25\xee\xce\x2d\xec\x47\xca\xda\x5b\x94\x4b\x81\xd8\xe7\xd2\xaa\x6b\x53\xe2\x41\x13\xa0\x4e\x09\x9d\x69\xe8\x7a\xd0\xf6\x03\x0a\x26\x7f\x41\xd4\x99\x15\xbb\x91\x2d\x5b\xe3\xb0\x98\x0d\x2b\xb9\x5f\xf6\x29\xa0\x5a\x75\x74\x10\xd7\x4a\xbf\xf5\x48\x1d\xa5\x20\x1c\xa0\x28\xe3\x46\x17\x23\x94\xcc\xa9\x2d\xcd\x1a\xdd\xa5\xc2\xdc\x4f\x3e\x4b\xd0\xa1\xac\xa4\x4d\x15\x3c\xbf\x37\xae\x4f\x6e\x00\x2d\x2c\x8b\xe1\x9e\x8d\x5f\xfd\x46\x81\x9f\xf3\xe3\x4d\xc6\x68\xea\x8f\xcb\xb1\x83\x04\x86\xa0\x96\x3c\x1c\x7e\xb4\x01\x0e\x4b\x91\x00\x32\x38\x3f\x07\xb8\xf2\x52\xe2\xfb\xab\xa1\xcb\x6a\x0e\xde\xa1\x2d\x5c\xb4\x98\x88\xa9\x08\x8f\x77\x4a\xf5\x9f\x19\x21\x89\xf2\x3b\x21\xd8\x6e\xc8\x69\x9c\x6e\x66\xb6\x70\x13\xed\x8e\x68\xd5\x39\xba\xa2\xd4\x0a\x1f\x68\x61\x11\xbd\x91\xa8\x7c\xdb\x9b\xba\x51\x15\xea\x15\x22\xf2\x7c\xef\x4e\x8e\xc2\x71\xd1\x51\x61\xab\x3f\x4e\x4b\x66\x55\x84\x90\x6d\xab\xa5\x17\xc4\xa8\x4e\x28\xc5\x2b\x31\xbc\x3e\xbd\x92\x74\x80\x08\x65\xe9\x3c\xce\x67\xd4\xf8\x98\x7c\xde\xd5\x95\xd6\x80\x28\x57\xdd\xe3\x3c\x63\x89\x7c\x19\x35\x97\x40\xda\xcb\xc2\xfb\x90\x2e\x82\xe4\x3f\x83\x57\xc1\xc1\x07\xc0\x1c\xce\x83\xb4\xb6\x3b\x7a\x4b\x39\xcc\x05\x08\x50\x7d\x77\xc0\x9b\x83\x31\xc4\x9e\xd3\x54\x8a\xa9\xd5\xcd\xa8\xa1\xdd\x21\x0e\xae\x3b\xf8\x24\x68\xbf\x1d\x9e\x9b\x28\x4a\xc1\x22\xd2\x5e\x6e\x96\x6a\xa3\xa1\x3c\x43\x17\xfc\xa4\xb5\x3b\x72\x63\x19\xd2\x0e\xd4\xbd\x2e\xb7\x36\x25\x25\x87\xcd\x16\x8d\x55\xaf\x34\x72\x69\xf7\x77\x2c\xcf\xf8\x32\xb7\xb1\x7b\xc4\x82\x9a\x8c\x7c\xcb\xb2\xe2\x6c\x6b\x23\x2e\x5c\xfc\x8a\xd5\x66\x74\x74\xf7\x07\x8a\xf2\xad\xc3\x61\x92\x4a\xab\xba\x76\x16\x44\xf4\x07\x0b\x36\x0f\xf5\xda\x94\x54\xc8\x6a\xbe\x32\xaa\xf0\xdc\xc6\x40\xc9\xbc\x0f\xb5\x42\xfc\x89\x50\xa7\x6f\xb1\xee\xab\xd9\xf3\x25\x3b\x17\x39\xed\x0b\xfa\x38\xf5\x09\xfc\x85\xb5\x86\x79\xd3\x44\x03\x97\x06\x28\x1b\xd4\x10\x7a\xaa\x44\x51\x21\xc5\x25\x7b\xa0\x85\x14\x09\x31\x3a\x2d\x59\x7d\xbc\x2a\x09\xd3\xe7\x68\x54\xa3\x1b\x9d\x91\xb7\x61\x51\x12\x34\xba\xef\xd6\xa6\x84\x94\xaa\xfb\x3b\x0a\xc8\x06\xf7\x22\x52\x24\x34\xfb\x12\xc6\x2c\x9b\xeb\x87\xfc\x7e\xa2\x7f\xad\x6f\x8a\x92\xe8\xdc\xd0\x67\xe8\x0b\x5e\x68\x5b\x8c\xae\x99\x2a\xff\x92\x41\x5c\x9a\xf7\xf6\x0e\xd1\x1b\xae\x37\x2a\xff\x58\x44\x06\xea\x9c\x0c\x09\x05\x7a\x72\x8c\x3d\xb5\xc6\x7d\xe2\x62\x90\x0b\x4b\xb9\x6f\xdb\x1c\x74\xe6\xb5\x7c\x30\x1f\x15\x60\x97\xe6\x26\x45\xd2\xb7\x0f\x9b\xab\xc3\x90\x5b\xba\x99\x87\x3b\x6e\xe3\x55\xa4\x8c\xc8\x25\x19\x0a\x6f\x8a\x14\x66\x07\x23\x0b\xba\xef\x99\x41\xc2\x3a\x49\x14\x6f\xfe\x51\x90\x51\xe8\x76\xbe\x82\x95\xed\xb9\xc3\xbf\x96\xa6\x2b\x52\xcc\x88\xec\x8f\x40\x7e\x37\x39\x3f\x37\xa5\x47\x31\xc7\xff\x3a\x6c\x47\x88\x4f\xe5\xce\xf7\x6a\xf6\x41\x85\x28\x4b\x9f\xc2\x41\xd4\xf5\x02\x1b\x2b\x0a\x6f\x58\x5b\xd4\xdd\x54\x77\x64\xd5\x02\x0b\x61\x0e\xef\xdd\x16\xf5\x07\xed\x6a\x1b\x96\x8a\xc7\xf5\xe1\x2d\xb3\x2d\x4a\xc4\xb4\xc6\xdd\x77\xba\x85\x4b\xa4\x0a\x6c\x41\x4f\x04\x2e\xfb\x04\x74\x86\x00\x32\xc4\xfe\xad\x2a\x41\x7d\xfb\x82\x2d\x3d\x9a\x2e\x9a\x7b\xcb\xa3\xaa\xd3\xb3\xf3\xf2\xd5\x83\x05\x49\x3e\xe2\x83\xae\x8c\x41\x61\xe5\xe6\x0c\xdb\x4f\x82\x22\x7e\xdd\x94\x34\xef\xf0\xdb\x5a\x97\x3c\x9c\x74\x8a\x72\x84\xda\xac\x0e\xee\xc5\x92\x36\xee\x65\x4c\x47\x5d\x9e\x74\xbd\x9d\xe1\x1b\x3e\x1a\xe2\x86\xf1\x5b\x0c\xa0\xb6\x35\x7d\x09\x51\x88\xdf\x38\x99\x91\x3e\xea\x21\xf4\x94\xe7\x81\xc6\x9a\xc6\x16\x15\x47\xb0\x7a\x9a\xd0\xaa\xb4\x3d\x8d\x4e\x7e\x3c\x90\x7d\x27\x99\x84\x32\x4f\xf4\x58\xbb\x06\xe5\x16\x90\x0b\xc8\xd4\xb4\x5a\xdd\x2c\x39\x84\x90\x10\xbd\xd5\x20\x2c\x4f\xda\x69\xa9\x68\x5d\xe8\x47\x61\xf6\xf6\x5d\x78\x2f\xbe\x70\xf0\x8d\x9b\xa7\x13\xbe\x37\xca\x61\xd7\xd8\x94\xb2\x93\x36\x85\x00\xc6\xc6\xba\x3a\x94\x48\xf7\x04\x0d\xd0\xc5\x3a\x20\xce\xdd\x6a\x66\x9d\x6b\x43\x1a\xe1\x08\x66\x5c\x6b\x9f\xe6\x28\xd0\xc1\x1f\xf4\xfd\xb4\xda\xa0\x26\x45\x52\x71\xac\x97\xe5\x45\xd8\xd1\x64\xba\xdd\x31\x78\x62\xf0\x76\xa0\x76\x51\xc6\xa1\x29\x0b\xb1\xeb\xc7\x21\xa3\x13\x39\xa9\x12\x96\xa6\xe9\x17\x0d\x30\xd9\xa1\x6e\x2c\xed\x1d\xf1\x86\xaa\x98\x76\xa4\xd1\x8f\xcb\x0a\xdf\xec\x2c\x48\x84\x45\xaa\x26\x5b\xc1\x07\x26\x5c\xc2\xfb\x77\x5b\x6c\x31\xec\x4b\x14\xf8\x5a\xdd\xd6\xe9\x6f\x39\x28\xd7\x65\x90\xe6\xe5\x86\xa7\xb8\xe6\x56\x51\xa6\x30\x1d\x4e\x56\x3c\xf6\xd7\x58\x6b\x61\x0a\xdb\xe6\x61\x31\x0f\x0e\x5f\x17\xf8\xa6\x7a\x52\xc0\x76\x17\xb4\x05\x8c\x6b\x45\xd6\xd1\x3b\xe3\x35\x9e\x24\xf5\x74\x0f\x19\xaa\xa2\x40\xb1\x1a\x77\xcf\x3d\x29\x07\x7f\xe0\x53\x58\x97\xb1\xdf\x4b\xb8\x3e\xb8\x77\x3c\x64\xfc\x68\x0a\x49\xe5\xcb\x0c\x57\x72\x2b\x9a\x8e\x06\x4a\x39\x70\x57\xc5\x09\x63\xd5\xcf\xad\x69\x9f\x47\x53\x47\xe8\xb8\x1f\x1e\xa5\x0d\xcd\x43\x17\xc5\xda\xaa\x7c\xf6\x19\x99\x09\xc7\x22\x3b\x5e\xb7\xc2\x27\xca\x22\x67\x7b\x93\xcd\xae\xb1\x62\x9e\x8f\x4d\x35\x58\xdf\x67\x50\xe4\x97\xe7\x80\xd9\xaf\x95\xba\xf6\xe8\x6a\xcc\xe8\xb5\x2d\x01\x53\x74\x72\x10\xd8\x8a\xf0\x5c\x8c\xdf\x97\x94\x53\x6d\xaa\x9f\x15\x33\xea\xa6\x89\x49\xc9\xd3\x7a\xb4\xe0\x9e\x08\xa8\x6f\x58\x4b\x73\xf1\x30\xc9\xcf\x27\x11\x90\xbc\xa8\x07\xf6\x65\xd6\x70\xb6\x16\x04\x75\x80\x56\xc3\x11\xb3\x57\x1c\xda\xfd\x93\xc6\xd0\x7e\x72\xa5\x33\xf7\x1b\x3d\x7f\xc3\xb0\xec\x63\x86\xbc\xde\xdd\x98\x20\x1c\x59\x30\x1f\xc0\x63\x0c\xad\xf6\x3a\x5d\x70\x9a\xf3\x36\xb3\x71\xba\xb4\x3e\x97\x83\xfd\xa5\xfd\x75\x1f\xf8\xc9\x27\x6c\x16\x0a\x25\x1f\xed\x5e\x84\x70\x8d\x98\xf9\x0a\xfb\x94\x15\x71\x99\xdc\xc9\x9e\x8f\x9f\x4c\xd4\xe7\xd3\x5a\x04\x56\x05\xe7\xd2\x60\x7d\x70\xc9\xeb\x44\x0c\xd2\x2d\xd1\x89\x28\x09\xd3\xc5\x14\xd1\x1f\x1a\xf2\x4f\x5b\xfe\x22\xa7\x15\xf1\x85\x14\x9b\x6f\xd2\x0a\x48\x2a\xf0\x02\x45\x98\x7b\x42\x4f\xc4\x98\x70\x02\xc6\x1c\x76\xa5\x8a\x98\xbf\xd6\xe9\x5c\x97\x1f\xa9\x8a\x2b\x29\xfb\x21\x4a\xb3\x05\xaa\x25\xe6\xaa\xd1\x09\xcb\x88\xf6\xb7\x27\xab\x8f\x1a\xc0\x63\xd9\xc0\xa5\x66\x84\x86\x46\x0a\x8d\xd7\x8e\x82\xb3\xad\x26\xc4\xc3\x05\x0c\x8a\x80\xe7\xfd\x39\xe3\xe1\xae\x07\x15\xb3\xf1\xd9\xa1\xd2\xcb\xdd\xb7\xd1\xfd\xeb\x1c\x32\x3b\x2d\xa5\x9a\xad\x16\x14\x69\xb6\xf8\x53\x91\x91\xf9\x9d\xca\x51\x13\xa3\xba\x00\xb3\x91\x24\x07\x1b\x75\x4b\xc8\x72\xa7\xaa\x6f\xc3\x2c\x47\x60\xc6\x89\xd4\x79\x6b\x2f\xda\x00\xda\x70\x26\x40\x11\x69\x46\x7b\x35\x4d\x5d\x81\xcb\x47\xfb\x8b\x40\x06\x9e\xde\xfd\x66\xcc\xb1\xab\x68\xe7\x9d\x18\x85\x34\x99\x01\x0f\x09\x83\x08\x6e\xaf\x97\x71\x1a\xa7\x24\x5d\x9f\x7a\x36\x37\xbb\xc5\xd8\xaf\x86\x0d\x14\x94\x68\x20\x48\x4f\xa4\x06\xf7\x62\xfd\x96\x77\xdb\xbb\x5f\x17\xea\x18\xa1\x96\xa3\xab\x0f\x33\xa2\x29\x94\x5a\x12\xf0\x1a\x93\xb3\xc0\x69\xd7\x7a\xa5\xfd\x7a\x61\xa6\xeb\xa4\xc3\xbf\xcc\x71\xb5\xba\x05\x9e\x3b\x66\xb9\x68\x10\x17\xc6\xd7\x40\x4f\x32\xd3\x12\x36\x9b\xd7\xd7\xe9\xfa\x9b\x16\x3d\x47\xc1\x48\xc6\xa1\xae\x63\x1a\xcf\x1c\xcb\xdb\x2b\xed\x11\x1e\x53\x94\x8d\xc8\x60\x1c\x12\x9d\xb9\x79\x76\xff\x5d\x4e\x8c\x2e\xa0\x6f\x07\xff\x64\x2d\xc9\x4d\xa9\xdc\xb5\x78\x8b\x84\x90\x55\xe1\xa2\x15\x73\x48\x89\x64\x88\x65\xcc\x41\xce\x85\x5f\x4c\x76\x6c\x6b\xf4\xf2\x17\x3b\x60\x08\x6e\x7b\x41\xe4\x43\x00\x60\xdb\x66\xc7\x20\x15\xb3\x88\x77\x89\x4d\xe7\x71\x88\x1a\x5d\xf1\x95\xef\xbd\xaa\xb1\x5b\xcd\xda\x2a\x9f\x06\x9a\xab\x49\xec\x56\x12\x3f\x96\x31\xea\xfb\x62\xcc\x2e\xd2\xd7\xc5\x5c\xc0\xad\xb3\x72\x79\xd7\xfc\xc9\xf3\x76\x1d\xd2\xda\xa0\x4f\x50\x0c\x32\xb1\x4a\xb7\xbe\x38\xcd\xd4\x25\xb0\x22\xc8\x01\x5f\xfb\xe8\x48\xc3\xf1\xe0\x85\x7b\x54\xa5\x19\x63\x2c\x8c\xa9\x01\xa3\xbc\x79\xfa\x62\x2b\x14\xb0\x22\xd0\x83\x66\x3f\x4e\x83\x05\x9d\x4b\x6b\x74\x2e\x1e\x13\xee\x25\xcb\x20\x6e\x5f\xcb\xad\xf0\x5b\xf4\xb5\x47\x76\x95\x2a\xf0\xbb\xd1\x10\x0e\xd2\xfd\xe3\x45\xd9\x77\x4f\xbd\xe2\x77\x9b\x5a\x2c\x38\x8f\x65\x16\xbc\x12\x7a\xd4\xf6\xdf\x76\xd4\xa3\xfe\x70\x69\xcf\x95\xc2\x35\xb3\xc0\x62\x1b\x0c\x10\x37\x92\xfe\xf4\x31\xb2\x7b\xd4\xae\x28\x3f\x11\xf7\x5d\x08\x0e\x5b\x24\xe5\xf7\x6d\x85\x78\x34\x8e\x96\x21\x61\xb6\x1a\x31\x16\x5a\x61\x1a\xb7\x78\x52\xa6\xd3\x88\x3b\x7c\x31\xac\x1a\x54\x59\x7c\x93\xd9\x5a\x46\xbe\xf9\xc7\x74\x1d\x88\x0e\x82\xec\xd6\xec\x89\x3b\x45\x87\x17\x06\x25\x94\x79\x09\x41\x29\xa7\x19\xf1\xda\xc7\x5b\x8f\x1f\x9e\x4f\x42\x59\xfb\x4f\x5c\x56\x71\x4c\x08\x7c\x2b\x15\xe4\x75\xc2\x1c\xb9\x57\xba\xa0\xdb\xc8\xa2\xe4\x1b\xa1\xb5\x89\x6f\x75\xf0\xed\xb4\x06\x17\xe5\x5e\xa9\xcb\xcb\x52\xc6\x80\x64\x24\x22\x1b\x3b\x88\x70\xcd\x05\xc2\xf5\x61\x7a\x05\x6c\xed\xd7\xc5\x70\x6f\x9b\x8a\xce\xb9\x83\xf1\xd1\x2a\xd0\x75\xe6\xdc\xa9\xb7\x99\x8a\x27\x58\x2c\xb1\x84\x95\x8c\xf4\x2c\x2f\xf4\xb1\xb3\x94\xa5\x37\x3e\x20\x39\x1b\x1c\x1c\x3f\xba\x3c\x4d\xf6\x98\x5e\x52\x2a\xb8\x8e\x54\x16\x84\xc5\x9a\x33\x3a\xad\x6f\x35\xac\x15\x0b\x3f\x12\x20\xde\xba\x35\xe5\xd0\xad\xee\xac\x38\x74\xc9\xce\x86\xa4\x3f\x9d\x25\x89\x21\x82\x04\x25\x79\xd4\xaf\x0d\x50\x46\x4e\xec\x21\x2d\x0f\xb1\x04\x75\xf6\x9f\x7a\x72\xa6\x4f\xbc\x9d\xff\xb9\xf9\x4a\x8d\x22\xc2\x4d\x0a\x81\x9b\x35\xb5\xe8\x87\x49\x19\xc3\x7e\x3a\xad\x6e\x34\xab\x13\x1b\x8a\x62\xcb\xb7\xb7\x6c\xb9\x94\x45\x49\xc2\xb3\x08\xa2\xb4\xd1\xc9\xca\x84\xf1\x8c\xdd\xe0\x50\xac\x6a\xe2\xac\xcf\x02\x0e\x60\x0d\x2f\x99\xa0\xed\xdd\x0b\xcb\xcd\x85\xd3\xe6\x06\x88\xce\xdc\x9a\x38\x9d\x57\xa2\xc0\x30\x4a\xd9\x9d\xb3\x19\xa5\x5f\xb8\x41\xe5\x65\x3d\x67\xb7\x3c\xb6\x07\xd7\x6c\x8e\x45\xd1\xcf\x2e\x4b\x51\xb1\xc1\xe0\x35\x75\xff\x18\x10\xd6\x1c\xf2\x22\x15\xbb\xf5\x72\xa6\xff\x5e\x07\x07\x9a\xcf\xa2\xca\x1a\x0f\x2d\x4d\xa3\x76\xbb\x3b\x62\xac\x38\x33\xe7\xb1\xfc\x80\x01\x8d\xc8\x09\xb4\xa2\x30\x2d\x9f\x0e\x9a\x82\x97\x51\xed\xb7\xa5\xfa\x40\x75\xae\x69\xc5\x9a\xd8\x88\xe0\x85\xb8\xf3\xcb\x95\x7b\xbf\x03\xd3\x46\xee\x83\x48\x7f\x5d\x50\x40\x0d\xfc\xd2\x56\x8a\xab\x5d\xa8\xeb\xe8\xd0\x48\x06\xfe\xea\xa8\x30\x52\xaa\xad\x69\x34\xe7\x9b\xc3\x19\xc9\xd2\xbe\xb3\xa3\xa9\xa5\xbd\xb8\xd2\x75\x87\x4a\x8c\x6c\xdf\x26\x73\x92\xeb\x4d\x35\x0f\xce\x0f\xd7\x40\x13\x4b\xd7\xc4\x34\x4c\x9b\xf3\x29\x8b\xc7\xf4\x04\x52\xe6\x78\x76\xfa\xa6\x4d\xb7\xdd\x62\x24\xef\xd2\xb5\xb5\x86\xd4\x61\x4f\xc8\xfe\x70\xaf\xc1\x1c\xb1\x7d\x4e\x70\xe0\x7b\x54\xa4\xef\x26\x2a\xab\x07\x9c\x31\xd6\xec\x39\x9e\xe6\xea\x8c\x8b\x5c\x1c\xd9\x77\xb2\xe3\xc5\x4b\x26\xea\x84\x3b\x2b\x91\xf3\x36\xe9\xbf\x4c\x59\x10\xd7\x78\xb8\x05\x16\xb3\xc1\x13\xc4\x7c\x0d\xb5\x4e\xe0\x91\xf2\x4f\x04\xc8\xc1\x26\x75\x14\x28\x69\xf8\xa2\x56\x68\xcb\xd6\x1f\xfb\xbb\x34\xc8\x9e\x5b\x7e\xf2\x11\x24\x43\xe9\x73\x8f\xf8\x34\x7c\xcb\xe2\x74\x69\x4f\xd8\x95\x97\x4c\x7e\x35\x57\x76\x2c\xe4\x9e\x72\xb7\x1c\x1a\x7f\x91\xa3\xa7\xf7\x49\xf0\xb9\xb7\x79\x57\x91\x37\x4d\x64\x0c\xd7\x1b\xff\x08\x03\x13\x31\x88\xfb\x7b\x92\x29\x17\x71\x87\x85\x68\x0e\x3e\x11\xff\x9a\xe6\xbe\x42\x43\xb2\x36\x49\xa4\x04\x60\x1b\x8e\x34\xf5\x09\x09\xd9\x13\xfd\x5d\x43\x94\x87\x51\x88\x56\x16\x3b\xe2\xc3\x67\x3e\xd5\xc3\x39\x7a\x38\x5f\x16\x96\x4c\x19\xb2\x1a\x8e\xc0\x38\x8f\xd3\xdb\x22\x30\x44\x83\x33\x9b\x5a\x3b\x98\xd4\xed\xd8\x98\x8d\x02\xf2\x7e\xba\xe2\xa2\xae\x6f\xd7\xca\x31\x6b\x5c\xa3\x08\xcf\x76\xbb\xc3\x65\xab\x4e\x53\x03\x03\x36\x82\xd8\x30\x91\x9f\x19\x73\xce\x38\x5a\x9a\xed\x61\x6d\x8b\x60\x00\xb8\x6d\x5a\x5f\x53\xa1\x62\x83\x79\xb7\xb6\x51\x95\x27\x2b\xa7\x45\x84\xd7\x05\xd9\x2d\x66\x03\xcf\x1f\xc2\xd8\xc8\x15\x94\x71\x11\x35\x40\x82\xd0\x00\x59\xdc\x84\x8b\xe6\xcb\xba\x5c\xf2\xbb\xe8\xc4\xa9\x77\x6a\xbb\xc0\x26\xdc\x79\xce\xfe\x1d\x56\xfb\x0a\xfa\x07\xa3\x67\xd0\x29\x9e\xff\x60\x8c\xae\x5a\xad\xdd\x59\xd6\x9b\xec\x4c\x2d\x8b\xcc\xcc\x0a\xf8\x12\x6f\x21\x6e\x6c\x37\x62\x7a\xaa\xb3\x7c\x58\x32\x26\xa7\xd4\xf6\x99\x00\xd3\xfe\x80\x04\xd5\x89\xa8\x57\x02\x3b\x08\xfe\x9a\x9f\xd6\x72\x34\xf7\xeb\x3b\x10\x61\xe3\x81\xf5\x8d\x5f\xdd\x59\x68\x30\x35\x64\x87\xf9\x69\x19\xb4\x03\xe0\x95\x2e\x60\xaf\x6e\x88\x01\x06\x79\x59\x3c\x12\xfa\x81\x66\x89\x16\xc6\x0d\x2c\x8c\x54\x12\xdc\x6a\x8c\x99\x77\x33\x4e\x77\xc7\xdd\x57\xfa\x1d\xf5\xa6\x82\x56\xe1\x9f\x8f\x83\xd6\xee\xcd\x32\x7f\x69\xb1\xb1\x16\x2c\x9f\xf2\x55\xd3\x36\x30\xc0\x8d\x91\xa4\xe3\x79\xdc\xc9\x5d\x1f\xde\x0b\x51\x23\x3b\x95\x35\x37\x43\x59\x31\x7e\x56\x1c\x78\xed\x29\x6a\xd0\x91\x75\xf8\xd8\x7d\x7b\x45\x32\xd1\x70\x09\x7e\x15\x54\x56\xf6\xad\x4f\xee\xa1\x0d\x07\xe1\x32\x5d\xd6\x27\x76\x54\x43\x15\xfa\xf8\x10\xb8\xd2\xbe\x0f\xe1\x77\x37\x78\xd0\xae\x31\xad\xc7\xfa\x8e\xdb\x8d\x4c\xd1\x59\xc5\xf7\x41\x75\x41\xf7\x54\x04\x96\xb2\x7f\x76\x57\x7f\xa4\x80\x2d\x99\x4b\xce\xdf\xd7\xe1\xf9\xdb\x24\xf0\x4a\x75\x2b\x1c\x6a\x55\x47\x85\xf3\x7b\xa3\xe2\xb1\x0b\x7e\xe3\x30\xde\x26\x84\x3d\x32\x1b\x3f\xe3\x95\x5a\x44\x1d\xfa\x1b\x14\xdf\xd6\xa5\x4f\x03\xcb\xbf\xb4\x40\x81\x16\xe0\x7c\x7c\x9f\x1c\x01\x0a\x68\x0c\xba\xdc\x04\x87\x1b\x35\x93\x23\x22\x26\xa7\xa7\x5b\x37\x11\x5b\xd4\xf9\x61\xa1\x57\x02\x60\x91\x8c\x11\xab\xd2\x2a\xca\x38\x9a\x31\x52\xc4\x31\x63\x15\x31\x48\x8c\x46\x32\x2e\xdf\xc8\xfd\x38\x67\x5d\x20\x7e\x01\xa5\x2b\x37\x92\xa9\x67\x96\x13\x9d\x8f\xde\x6b\x23\x06\x63\x81\xb9\xc9\xab\x7c\x38\x47\x1d\x7c\x1b\x9b\xbd\x5b\xcb\x02\x55\x86\x6f\xa1\xa1\x72\x13\x4c\x55\x5c\x57\xed\xdf\x8b\x4a\xd4\x5e\x85\xf4\x08\xce\xb4\xd4\xa8\x81\xe0\x97\xc9\x2f\xac\x9e\xdc\xb2\x28\xc5\x12\x7f\x1d\x6d\x22\xf4\x77\x3e\xdd\x1a\x7f\x7f\x5e\xbb\x36\x59\x91\x77\xee\xec\x37\x43\x4c\x14\xa5\xbd\x38\xbd\x72\xd8\x8c\x23\xc9\xd2\xb9\xdb\x54\xab\x20\x61\xb8\x1b\xee\x9c\xee\x27\xdd\x75\x68\xca\x0c\x33\xc0\x5f\xd8\x35\xc5\xbb\x38\x5d\x32\x33\x43\x7e\x9e\x7f\x4a\xbf\xf6\x99\x91\xb2\x29\xc0\x4e\x18\x48\x6e\x2d\x27\xee\x8f\x70\x47\xf7\xda\xe4\xfd\xa9\x63\xbf\xd9\xe9\xc4\x5c\x52\xf3\x46\xa6\x78\xb3\x34\x6f\x98\xfe\xfa\x28\xed\xc6\xb0\x08\xaf\xaa\xa4\xb8\xb3\x6c\xe7\xf3\x75\x71\x34\x48\x88\x83\x73\xe5\x13\xa4\xb4\xca\xdb\xd5\x10\x30\x49\x31\xbb\x71\xb0\xba\x79\xd5\xec\x48\x98\x05\x6e\xb7\xbc\x54\x79\x25\x86\x1d\x8f\xa7\x97\xe3\x6c\x89\xd9\x9e\xbf\xd3\xa2\xd6\x77\x4d\xf0\xb3\xa6\xf4\xbc\xfc\x5c\xf0\xbd\x71\xae\x9a\xbb\x4a\xfc\x42\xfd\x9c\x9e\xbd\x88\xb5\xe6\x37\x3d\x38\x02\x78\xb9\x6e\x9d\x21\xc2\x9b\x21\x07\x7a\xbe\xc6\x95\xdd\x28\x67\x4c\x85\x42\x86\xc1\x82\x2c\xdd\xb7\xda\xeb\x52\xa4\x48\x05\x12\x70\x28\x06\x8c\xd3\x61\xac\x21\xd4\x43\xba\xfe\xcd\xe6\x7d\x35\xb3\x9e\xd1\xfe\x6b\xa7\x85\x8d\xdb\xa2\x5f\xb9\x39\xcc\xc7\x93\x4d\xb5\x33\x12\x25\xfc\xd4\x77\x5b\xaf\x6d\x19\xe9\x6d\x2a\x7c\x55\x82\x3b\x8a\x42\xd5\x4c\xe9\x41\x8a\x0c\xde\x0e\xea\xeb\xfb\x4b\x78\xc7\xc1\xa0\x47\xd0\x48\xe1\xd7\xe3\x39\xb5\x8e\xc3\x9b\x3e\x2c\xf2\xd4\x61\x7a\xc7\x4b\x45\x4f\x93\x8c\x98\xa0\x3c\xda\xd0\x27\x24\x00\xa3\x8e\x1a\x67\x07\x07\xe2\xc6\x46\xa7\x1c\xb4\x17\xce\x97\x1d\xb8\x72\xa9\xd0\x0c\xa1\x32\x2e\xf3\x5d\xf1\x01\x2f\x0f\x8e\x3e\x9a\xad\x71\x5f\xbd\x23\xcd\xab\x7c\xac\xd4\x52\x37\x03\xf8\xe4\xf7\x2e\x8b\x92\x76\x3b\x5a\x67\xfc\x8b\x65\xd1\xfe\x5f\xb7\x8e\x02\x0c\x43\x6a\xfc\xe2\x02\xf8\x69\x97\x70\x28\xcb\xa9\x08\xb5\x49\x29\xc7\xa5\xfc\x2e\xa2\xa9\x7b\x50\x8d\xd6\xb2\x61\x7b\x2e\x9f\xb0\x38\xfb\x5d\x60\x26\xf8\x84\x04\x38\x2f\xae\xba\xd3\xaf\x97\x0a\xcd\x73\xae\x72\x9d\x87\x1f\xdd\x33\xb4\x03\xe2\xfc\x1f\xe0\x54\x52\xdd\x3b\x2d\x88\xb3\xb4\xf5\x3a\xf9\x22\x36\x1e\x65\x87\xa0\xc8\xe3\x3b\x0f\x38\x75\x7d\x74\x8a\x2a\x39\x92\xdd\x8d\x8f\xb7\x06\xcf\xd1\x8f\x88\xee\xa7\x82\xc2\x14\x4a\x0b\x91\xde\x41\xc9\x3d\x62\x2e\xd8\x6c\x6f\x14\x0f\x23\xb7\xd2\xb9\x4b\x0a\xb6\x07\x62\x3b\x69\x53\xd3\x77\xc4\x9f\x24\xb5\x31\xaf\x38\xee\x6f\xd3\x23\x4d\xa8\x68\x7e\xde\xfa\xb5\xae\xd4\xa6\x7f\x5e\xaa\x00\x58\x16\xf4\x34\x71\x1f\x3a\x46\x73\xf6\x6f\x18\xd5\x42\x9a\x62\x80\x0f\xbe\x38\x0a\xf7\x3f\x76\xdd\xfc\x7a\xd1\xa3\x7c\xe6\x69\xc6\x37\x25\x02\x2b\xe8\x72\xd0\x76\xab\x8e\xb8\x0a\x1d\x16\x44\xd2\x01\x99\xbb\x86\x43\xa9\x84\x20\x21\x4b\x38\xd6\xeb\xe8\x30\x0b\x1f\xdb\x62\x07\x7a\xe4\xcf\xf6\x0b\x63\x88\x84\x79\xb5\x25\xd4\xa0\x42\x97\x6c\x1b\x19\x1e\xfd\xf8\x76\x82\xbe\x94\x65\x4b\xc6\x07\x61\xc8\x3c\xe8\x47\xc7\xcb\xc3\xa0\xa9\xd9\x24\x71\xdd\x67\xcf\x3b\x40\xca\xfd\x9c\xcb\xf8\x85\xd8\xc2\x84\x13\x32\x74\x9c\x08\xbb\x3a\x37\x3c\x52\xd1\x67\xca\x15\xa2\x0e\xde\x2e\x68\xa9\xb2\x23\x9c\x52\x99\x76\xd9\x74\x2a\x3a\x98\x5a\x8c\x8b\xef\x5c\x1a\x62\x36\xb0\xbf\xe7\x24\xf9\xd0\x39\xde\xcb\x3e\x47\x2e\x6b\xc3\xca\xc3\x7a\xb2\x47\xd1\x01\x4c\xd1\x3a\x3e\xd6\xaf\x6a\xba\x72\x60\x66\xb8\xed\xa9\x18\x7c\x5a\xc4\x41\x12\x16\x61\xe5\x64\x2b\x6b\x5f\xc3\xca\x59\x9e\x67\xa1\x46\x33\xfd\xe5\x9a\x2a\xae\x17\x6b\x74\xfe\x35\x19\xaa\x50\x13\x9e\x0a\xce\x2b\x8f\xc9\x4b\x0f\x60\x2e\x85\x14\x84\x51\x47\x22\x54\xbb\x03\xd6\xd6\xc8\xe4\xf7\xf6\x5a\x5f\x8b\x82\x46\xfa\xb8\x9b\x11\xcd\x38\xe7\x2e\x73\x9c\x5d\x86\x00\x65\x50\x55\x79\x5e\x0b\xe1\x1b\x32\x2c\xf1\x52\x3e\x96\xad\x8b\xf6\xa0\xbe\xfb\xa2\x2b\x22\x6b\x57\xa0\x86\xca\x59\x2a\x33\xda\xd8\x89\x2c\x88\xb4\xd0\xb8\x17\xfd\xf2\x2e\xde\xc7\x9a\x2a\x4d\xd9\xbb\xdd\xb3\x9f\x23\xc8\x7a\x7b\xac\x51\xf6\xa3\xb9\xef\x48\x9d\x3c\xf9\x73\xd0\x7a\x63\xf3\xdb\xe5\xe4\x4c\x18\x00\x56\x7e\x25\xa6\xa0\xa7\xcb\xe6\x88\x49\xed\xf8\xcb\x94\x7c\x16\xab\xcc\xfd\xc9\xc9\x3b\x54\x64\x8f\xc9\x6a\x23\x62\x42\x5a\xe4\x57\xf8\x80\x15\xd5\xe0\x87\x91\x18\xd4\x91\xde\x09\xb6\xfc\x01\x6e\xb5\x78\xc3\xdf\x94\xf8\xf4\xd4\xfc\x29\xad\xc2\x23\x1f\xb8\x24\x09\xd6\xa4\xa4\x42\x34\x6e\x8f\x2f\x9e\x1d\x24\x9d\x7e\xd3\x6d\xe3\x83\xd9\xcf\x1a\x8b\x1f\x38\xa9\x9e\xfa\xb6\xac\x47\xc3\x71\x7d\x1a\x59\xbf\x0f\x1a\x11\x4a\x00\xe3\x73\x82\x4d\x29\xa4\x44\xed\x2c\xb7\xe1\x8d\xb7\xda\x3b\xa9\xe5\x86\xcb\xcf\x31\xb5\xcb\x4e\xdf\x51\x61\xeb\x8f\xd2\xf0\x9f\x5d\xa0\x35\xb5\xcc\xb3\x20\x8e\x8d\xc6\xe8\x84\x92\xd2\xde\x2e\xbf\x02\xa0\x82\xe2\xbb\x0c\x32\x35\xf6\xd7\xca\x1b\xaa\xe4\x67\x22\x22\x43\x07\x64\x21\x89\xe2\x19\xe2\x26\x67\xfd\xaf\x61\x8d\x19\xa7\xd7\xa0\xde\xc9\xb4\x4c\xea\x28\x6b\x47\x8e\xd9\x06\x9c\x3a\xdc\x0c\xef\x7c\x7a\xff\xce\x46\x2f\x43\x87\x6f\xfe\x01\x0b\x8a\xaa\x77\xe1\x14\x99\x7e\x8f\xf1\x66\x86\x79\xb8\xc3\x0f\x28\x80\x07\xa0\x27\xea\x38\xc4\x72\x6a\xbc\xce\xd2\x19\xad\xdc\x9a\x77\x2d\xef\x03\x04\x6e\x5b\x8d\xe4\x62\x93\x95\xdf\xec\xcd\xe4\xdb\x66\x51\x51\x66\x92\x28\x2a\x0b\x55\x6a\xbe\x64\xff\x56\xca\xd7\x46\x04\x87\x24\x96\xbe\xf6\x92\x59\x05\x76\xc9\xc8\xa7\x09\x21\x5f\xb7\x03\x08\xf1\xdb\xaa\xb6\xd9\x51\x86\xfa\xc3\x4e\xb9\x27\x6d\x3d\xc9\x1a\x25\x61\xb6\xcd\xa9\x63\x12\xa1\x73\xe0\xa7\x1c\xfd\x5b\x59\x96\x93\x32\xff\x78\xdc\x8d\xaf\x95\xd7\x59\x16\x91\x94\xb3\x83\xf5\xfe\x9c\x3f\x7b\x0c\xc2\x12\x31\x03\xa4\x57\x05\x48\x73\xdc\xed\x94\x1e\xc4\x91\xf2\x0a\x04\xad\x4c\x6a\x2f\x1f\x4a\x46\x88\x5d\x92\x3e\x4e\x6c\xac\xde\x21\xb5\xe8\x25\x28\x3a\x29\x07\x2a\xe4\x9f\x77\x67\xb6\xeb\x3d\x17\x75\x49\xd1\xa2\x2a\x21\xb6\x59\x79\xdb\xb3\x9c\xf0\x76\x28\xaf\xf2\xcb\x4c\x3c\x36\xa6\x21\x8d\xb4\x31\xe2\x43\xac\x6a\x77\xef\xe8\x8c\x0d\x1b\xe3\x49\x4a\x41\x89\xd0\xe0\xa3\xb5\xd2\x6e\x92\xea\x81\xab\x5d\x59\x6a\x62\x1b\x42\x9e\x24\x56\x99\x98\x3b\xb0\x7d\x70\x76\xa8\xc6\x72\x4f\xc6\xfe\x2f\x95\xa2\xdc\xb5\x2a\x9c\xde\xfe\x99\xc9\x2e\xb9\x8e\x92\x9f\x4c\x59\x6a\x56\x8c\x3b\xa5\x6d\x6b\x45\x69\x95\xe5\x79\x69\x35\x00\xf3\xc7\x49\xe9\x5f\xd4\x16\xc5\x28\x97\x5f\x9b\x81\x0a\x07\x64\xf4\x30\xb3\x40\x9b\x82\x26\xd6\x41\x50\x4c\x97\x04\x5c\xaf\x7b\x00\x13\xb0\x9e\x6c\x25\x02\x17\x72\x26\x49\x06\xaf\x2c\xcd\xdf\xe6\x89\x81\x3e\x81\x2a\x22\xf3\x52\x3a\x4a\xc4\x8f\x3d\x5b\xaa\xe4\x7b\xe1\x3b\x22\xf8\xa9\x36\x04\x1f\x07\xdf\x2f\x3b\x51\x3d\xa4\xbb\x44\xbe\xcf\xa0\xef\x98\x5e\x13\x8a\x6e\x60\xce\xed\x42\xa6\xbd\x56\xdf\x9e\x20\xc1\x2f\x2c\xb1\x22\x9e\x6b\x38\x54\x44\x11\xb5\x06\x94\x76\x15\x91\x65\x5a\x2c\xde\xeb\x79\x81\xd7\x5d\xd5\x08\x0e\x63\xdc\x45\x13\x99\x2e\x57\x77\x6b\x91\xd3\xe5\x3c\x52\x58\xa4\x46\xf9\xb5\xe9\x52\xcc\x5c\x5f\x7d\x5c\x13\x50\x65\x9d\x88\x29\xb7\xc6\x37\xb8\x58\x96\xfa\xc4\xde\x9b\x12\x24\x80\x83\x4e\x11\xac\xed\xc9\xeb\xa5\x77\x62\xf4\x01\x45\xc0\x6b\x7b\xcd\xc2\xeb\xa9\x0a\xc9\x2d\x3e\x56\x33\x04\x83\xbc\xda\xae\x6d\x3c\x85\x94\x55\x7c\x26\x25\x7a\x1b\x36\x0e\x53\x7c\x59\xd9\xa7\xac\xea\x12\xca\xd7\xcd\x7a\x90\x16\x73\xec\x98\xa0\xaa\x38\x7c\x7d\x58\xab\xbe\xa5\x7a\x71\x0a\x87\x9e\x3e\xb8\xcd\xcc\x23\x27\x09\xff\x27\x19\x12\xa3\x99\x83\x72\xfd\x8d\x4a\x2b\x17\x6f\x89\x64\xe4\x9a\x8c\x1c\x39\x85\x88\x95\x75\x73\x73\xd1\x55\xb9\xb3\xed\x74\x32\xe1\xcb\x04\xb2\x8a\x21\x59\x2b\x4a\xb8\x4e\x0e\xcd\xce\x5a\x5d\x01\xb5\x93\x8b\x63\x5c\xe9\x2e\x9b\xd3\x22\x6e\x52\xc1\x91\x1b\x14\x15\x4c\xcd\x46\x29\xad\x12\x98\xea\x62\xe4\x65\x58\xd4\x6f\xa2\x5e\xbf\x47\xdf\xac\x93\x2a\xb6\x33\xa9\x80\x16\xd1\x2e\x88\x12\x54\x36\x19\xfb\x0a\xd3\x7f\x5c\x6a\x85\xd3\x17\x2a\xc8\x68\xda\x3b\x3a\x66\x84\xe7\xe2\x12\x33\x3d\x41\x62\xe9\x4a\x57\x6a\x8b\xb3\x16\xf3\x3d\x14\x2b\x59\xf6\x4a\x73\x2e\x05\x40\xa3\x21\xbe\x35\x04\x92\xf3\xf4\xad\x59\xf1\xae\xdd\x9a\xda\x5b\x3b\xed\xc6\x96\xd0\xb7\xf9\x96\xd6\x7c\x51\xa7\x7b\xea\x4a\x59\x2a\xf2\xa3\xf4\x16\x26\x81\x62\x7f\x4c\x23\xa6\xaa\x69\x33\xae\x8e\x45\x68\x61\xb2\x11\xef\x6a\x2a\x2d\x79\x9b\xdf\xfd\xe3\x15\x6c\x5b\x6d\x8a\xeb\x42\xf8\x45\xb0\x89\x5a\x67\x4e\x1d\xf5\x52\x57\x0a\xb4\x67\xc8\xc8\xcd\xc5\x3a\xd2\x43\xff\xba\xbd\x13\x56\xf4\x98\xed\x92\xd5\xf4\xc9\xd4\xf5\xc3\xb2\xee\xee\xb2\xde\x12\x8e\xd9\x12\x0d\xad\xa2\xf7\xc1\x8f\x23\xf0\xaa\x90\xc0\xc5\x7b\x0c\x2e\x6d\xce\xf0\xae\x99\x1c\x75\x28\x98\x38\xae\x2d\x4f\x67\x01\xcf\x83\x24\xe7\x73\x10\x15\x48\xf5\x55\x9b\xbc\x22\xdd\x4d\x36\x42\xc3\x36\x96\x1e\x15\x4b\x61\xa5\x04\xdf\x78\xbc\x18\x36\x0c\xe4\xf6\xf0\xfe\xfa\xf1\xb8\x20\x69\xa3\xd9\x34\x51\x87\x97\x3d\x1f\x3c\xd8\x88\xe5\x84\x83\x8e\x5d\xf4\x40\xfe\xde\xf4\x7e\x3e\xf5\xc3\xf1\xa0\x43\x41\x82\xc1\x67\xbc\x1f\xe8\xc4\xbc\x7e\x08\x2e\xe8\x05\x98\x20\xe9\xfa\xf6\x0d\x1c\xe3\x01\x79\xac\x16\x16\x54\xa8\x7a\xab\xa2\x0d\xcf\xeb\x23\x82\xbb\x9f\xe0\x03\x92\xa7\xf2\x9a\xda\xce\x25\x59\xd4\xf7\x24\x4c\x4f\x95\x56\x25\xf2\xf2\xb3\x4d\xf3\xf9\xd4\xd9\x3c\xcd\x17\xfa\xe3\xb3\xcb\xd7\xf3\x11\x50\xd0\x8a\xde\xbb\x46\xb5\xa3\x83\x51\xeb\xca\xbb\xd5\xc4\x78\xe5\x3e\x3b\xd8\xad\xc3\xcf\xa7\xbb\xb1\xcf\x9c\x81\x18\x97\x9a\xc9\x9e\xfb\x87\xaf\x3f\xfa\x8c\x0b\x7e\x3c\x53\x52\x72\x37\x23\x3e\x12\x8b\x73\xc5\x8a\x16\x27\x5c\xe1\x12\x08\x82\xb4\x78\x12\x5c\x1f\x10\x88\x73\x5c\xc4\x5a\xee\xdb\xee\xad\x22\xce\x3f\x7a\x74\x92\xf6\x46\x8d\xd9\x46\xc5\xc9\xae\x6f\x4f\xac\xd4\x95\xb4\xde\x5e\x2d\xdd\xf4\xa4\x8d\x55\x07\x14\xed\x36\x8d\x00\xb5\x47\x40\x5f\x72\x27\xc3\x36\xf2\x7c\xf9\x6b\xae\x84\x30\xf0\xf4\xab\x12\x9f\xd5\xdf\x55\x40\x4f\x92\x10\xcc\x61\xe4\x25\x99\xe3\x19\x67\xc4\xbc\x4f\x7e\x4a\x5f\x4c\xee\x74\x6c\x14\xa8\x59\x6a\x80\x89\x7d\x34\x76\x69\x54\x90\x31\xea\x45\x2c\xea\xca\x4e\x47\x50\xf2\x75\x29\x5b\xdc\xfb\xc9\xa3\x71\x71\xda\x67\xfc\xfd\xe6\xdb\x93\xbd\xb5\x24\x52\x7c\x95\xbd\xeb\x11\x38\x51\x62\x67\xff\x91\x64\x07\x68\x1c\x27\xd3\xd3\x8a\x1e\x7d\xe3\x7b\xba\x65\x72\xa3\xd8\xf1\xba\x38\xc9\x3e\x47\x10\x11\x1e\x25\x58\x3a\x69\xa6\xdc\xf1\x12\xf8\xb4\x7b\x53\x2a\x64\xe1\x9c\x50\x30\x6b\x64\x37\xbb\xe7\x4d\x1e\x30\xca\xd6\x04\x40\x54\x46\x64\x53\x62\xb2\x3d\x20\x41\x84\xb4\x35\x19\xf9\x30\xef\x2d\x27\x05\xc1\xbd\x27\x09\xf1\x4b\x71\x29\x4b\xab\x72\x23\xbd\x90\x2a\x21\x10\x09\x9a\xd1\xa8\xa8\x27\x3f\x20\x2c\x7d\x09\xfd\x52\x8c\xd8\x66\xc3\x5a\xdc\xbb\x95\xb6\x65\x42\x8b\xa9\xbe\xcb\xfe\xf9\xc4\xe7\xb4\x51\xe8\x25\x07\x2f\x93\xc2\x1b\x18\x19\xfe\x05\x69\x4e\x57\xa9\x34\x1e\xcb\xf5\xe0\x19\xb0\x0a\x5b\x2a\x59\x52\x80\x12\xb1\x42\x13\x81\xd4\xcb\xdb\xbf\x50\x59\xb2\xdc\x58\x3c\x70\x0e\x8a\x9d\xea\x83\xc9\xed\x99\x2a\xef\x87\x0e\xe6\x43\xaa\x61\xb3\xbc\x8d\x70\xcb\x75\xdc\x18\x45\xf5\xba\x6f\xec\xe5\x42\xfa\x91\x42\x28\xbf\xc2\xb3\x2c\x09\x1f\xcb\x45\xd1\x22\xdb\x54\xa2\x5f\x23\x2f\xb1\x72\x1a\xc1\xd8\x00\x3b\x53\x7c\xeb\xc2\x59\x5f\x29\xca\xbe\x25\x28\xdf\x50\x09\xa5\x3e\xb9\xf1\xb7\x2f\x5c\xca\xc5\x2f\x51\x1f\x4b\xe2\x6e\x1c\xca\xa7\xa7\xad\xbe\xa7\x0a\xf2\x4b\x84\xf6\xdf\x21\xa3\x49\x3a\xb0\x2b\xe2\xf6\xac\xb0\xf6\xe5\x3f\xdc\xe7\x19\xf1\x5b\x7f\xba\x1f\x0e\x63\x84\x25\xb4\x53\x12\x57\x49\x1a\x80\xe8\xae\xb8\x5d\x1c\x6c\x0c\x1b\xa3\x1e\x7c\x94\xd5\x93\x2b\x41\x57\x68\xf8\x84\x6c\x68\x77\xd7\x75\xf1\x6d\xc4\xd3\xf2\x89\x30\xa9\x62\xea\xb5\xda\xf5\x58\xd2\x1c\x7a\x7c\xaa\x97\xde\x62\x76\x59\x8e\x6b\x59\x0d\xdf\xf7\x52\x53\x2b\x0e\xf6\x02\xd4\x86\x80\xd0\x6a\x81\xaf\xe8\x31\x47\x44\xbd\xa6\x5c\x12\x5c\x1c\x02\xd5\x43\xf6\xc6\x8c\x77\xd5\x35\x31\x7a\xe6\x5d\x34\xb0\x78\xe4\x04\x0c\x38\x0a\xfc\x6d\x60\x06\x9b\x8c\xa4\xb8\xe8\xe6\xb9\x2a\x69\x58\xb8\xab\x7b\xfb\xf7\x2c\xa4\x41\xe2\x19\x2c\x27\x7b\x1e\x0f\xaa\x18\xe2\xa7\x09\x45\x85\x64\xdd\x66\x51\x70\x89\xa3\x75\x27\xa3\xa8\x56\xf8\xf8\x2c\x85\x18\xda\xde\x0b\x86\x69\x8c\x71\x61\x3d\x5d\x7c\xf7\x93\x6b\x53\x9d\x72\xd1\xaf\x7c\x79\xe7\x6f\x03\x17\x5d\x3c\xeb\xd5\x90\xa1\xb0\xbc\x39\xc8\x83\x3c\x5b\xdb\xe7\xf7\x55\x7b\x51\x2b\xfa\x08\x56\xa3\x80\x99\x2a\x10\x54\x56\xab\xbc\xa0\x2e\x18\xb6\x5e\xb7\xb3\x2e\xbc\x4f\x0a\xa8\xb9\x6f\x69\x66\x5a\x87\x88\x20\xe2\x9b\xc5\x48\x8f\x05\x0c\x72\xa6\x92\xe9\x16\x95\x85\xa9\x39\x69\x6f\xab\x21\xb8\xa0\xef\x72\x97\x73\x17\x69\xe2\x42\x0b\xb5\x3e\xb3\xfb\xbd\xe0\xc9\x82\x87\x9e\x6f\x3a\x7b\x63\x99\xe6\x27\x2a\x09\xe5\x63\x4d\x57\xa8\x49\xe3\x89\xc8\xeb\x9a\xe4\xb5\xda\x8e\xf3\x6c\x36\x06\xdd\xb8\x46\x97\x8c\x23\x89\xf8\x28\x09\x26\x2e\x90\x9b\x13\x94\xbe\x2f\x93\x9a\xfb\xd9\xe9\x65\xae\x19\xfa\xd0\x3d\x93\xd0\xde\xf3\x8e\x33\x0e\xb6\x8b\x72\xed\x0a\x1c\x21\x50\x36\x97\xc1\xee\xce\x5c\xdb\x02\x85\xf0\x79\x58\x37\xd1\x62\xd8\x37\xa3\x71\xb4\xd6\x4e\x2a\xd6\x4e\x09\x7c\x9b\xb1\xad\xfa\x41\x1d\x36\x91\xb4\xdf\x57\x61\x36\xc5\x84\x6e\xda\x62\x2f\x72\x14\x18\x5e\x4d\x30\x72\x1d\x11\x95\x25\x91\x05\xc3\x5e\xaf\xdb\xcb\x74\x63\x6b\x98\x97\xb0\x29\xe6\x84\x3f\x97\x03\xdd\x7c\xce\xa7\xf2\xf6\xe6\x55\x22\xbf\xe9\x29\x9b\xf7\x84\xc4\x59\xa0\xd0\x40\x2d\x5a\xa9\xd5\x5c\xca\x60\xd9\x60\x78\x9c\x8d\x96\xa0\x7f\x7a\xc2\xa7\xd7\x7f\x72\xe2\xb9\xb7\x34\x65\xa0\x23\xbe\x04\x29\x0f\x5d\xbc\xdf\xd1\x10\xc9\xff\xb9\xa3\x37\x3c\x86\xa5\x99\x89\x04\x9b\xfd\xcd\xae\x3b\x54\xb9\x96\x8b\x30\x7a\x55\xf3\xb6\x92\xda\x49\x32\xde\x62\x46\x36\xb8\xcf\xd8\xf0\x04\x1d\xa5\xd2\xc4\x95\x9a\x63\xbd\xac\x48\xac\x66\x28\x14\x78\xf1\xb1\x0d\x8d\x14\x23\xc8\xb6\x67\x9d\x4f\xd7\xdb\xda\x3a\xef\x74\x42\x2a\xc2\xc1\xd9\xe8\xcb\x9d\x4e\xf7\xb5\xda\xe6\xc1\x46\x69\x46\x0d\xea\xf4\x9b\x58\x68\xd1\xc9\x60\x37\xd6\x61\x76\x44\x73\x88\x00\xc7\xc5\xfb\xf9\x88\xb4\xbe\xf1\xbd\xf5\x73\x9b\x56\x6a\xe3\x0f\x89\x33\xbe\x1c\x70\xda\x59\x96\x74\x1d\xe2\x87\xef\xb8\x90\xa7\x3b\xfc\xb0\xa0\xe9\x33\xe4\x33\x75\x2e\x94\xe8\x43\x10\xf9\x83\xdf\x13\x96\x4c\x6b\xcb\xec\x36\xc3\x51\xbe\x14\xf6\xe4\xe6\xf3\x85\xb5\xf5\x4e\x0c\x59\x77\x08\xf7\xe2\x43\x8e\xf6\x6a\xc5\x6b\xd8\xc7\x79\x84\xe0\x34\xcc\x1b\x62\x68\xc5\xf5\x6f\xd3\x3f\x8d\x65\x5f\x02\xec\xf3\x43\xd8\xea\x8b\xea\xcd\xba\x44\xde\x0e\x57\x77\x54\x03\x80\xcd\x4b\xff\x72\x49\x49\x97\xf9\x90\xd5\x9f\xe6\x53\xd2\xcc\xaf\x01\xa9\x6d\xc8\x97\x86\xce\x67\x3d\x04\xd7\xe0\x7c\x45\x39\x45\xfc\x49\xbe\x0d\xdd\xed\x60\x3e\xf0\x44\x65\xfe\xac\xfc\xe9\x6e\x9c\x05\xaa\x7a\x84\xc2\xee\x72\x92\x6b\x0b\xa7\xbf\x2d\x24\xb2\x9b\x34\x80\x98\x0e\xf7\xcd\xa7\x4f\xc9\x7a\x5e\x4e\xaa\x3e\xc6\x65\xc2\x8e\x71\x54\xe6\x47\x9d\x6e\xef\x01\x18\x01\x93\x06\xd5\x6e\xb5\x86\xc4\xbe\xa7\x8f\xc1\xd5\x59\xda\x5a\xde\xc3\xea\xb5\x1b\xa5\x23\xe0\x40\xcd\x0e\xb7\xef\xc7\x58\x95\x1a\xbd\x2b\xf8\x55\xaf\x3f\x41\x84\xd8\xce\x2e\xe2\xd2\x6f\x6e\xfd\x2c\x66\xc3\xf1\x5b\x72\xf2\x2f\x89\x3a\x96\xec\xf3\x10\xfc\x6a\x34\xd6\xac\x37\x67\x55\x50\x52\xb5\xad\xf2\xf1\xdc\x4e\x92\x82\xe8\x83\x5a\x91\xde\x3c\xc2\x6b\x10\xcb\x32\xf4\x68\xed\x66\x79\x1a\x95\x9d\xcc\x6b\x9a\xca\x4e\x54\xff\xfa\x30\xd2\xd3\xe4\xcd\xc5\xc6\xdc\x34\x4a\xc7\x90\x37\xe4\x71\x11\xec\x78\x09\xb9\x82\xdb\x28\x89\x08\xae\x52\xde\x13\x80\x72\x82\xc2\xcb\x7f\xd8\xf0\x2e\x66\x4c\x45\x62\xfd\xca\x0c\x89\x8e\xae\x09\x3f\xbe\x1d\x31\xee\xcd\x6e\x06\xe7\x9f\xaf\x4a\x96\xb3\x79\xb1\x4e\xc7\x54\x5a\xa1\xf6\x8e\xf1\x76\x7e\x99\x00\x08\x0f\x5d\xe7\x46\xf7\xf1\x39\x96\x03\x60\x8a\x26\xc5\xba\x55\x62\x53\xa2\x66\x1c\x56\x48\x23\x7d\x28\x3a\xd4\x6d\x9a\xf7\x69\x1b\xb8\x59\x55\xb5\x17\x54\x92\xc0\x8a\x91\xe3\x9e\x82\x69\xef\xaf\x2d\xe8\xc5\xe0\x42\xfc\x31\xe8\x55\x09\x84\xbe\xff\xbc\x37\x4b\x99\xff\xe4\x52\x70\xf4\x81\xb6\x0f\xb6\x6b\x6f\x83\xa0\xad\xd6\x62\x66\x49\x98\xb0\xd5\xdd\xc4\xe3\x21\x34\x86\x68\xed\xf2\x5a\xec\xf5\xc4\xa2\x2d\x45\x4e\xe1\xd7\x04\xb3\x03\x6f\x17\x99\x04\x4f\x62\xd5\x5d\x4f\x8d\x8a\xa6\x93\x69\x4f\x47\x73\x71\x16\xba\x60\x52\xf4\xf0\x4c\xd3\x7b\x21\xc9\x67\xe1\x8d\x01\x3f\xf3\xef\xd7\x01\xe7\x52\xd4\xf0\x1d\x36\x95\x1c\x13\xe3\xce\x4f\x91\xdf\x0f\x0f\xfc\xd4\x17\xba\x59\xfa\xd4\x57\x31\xf1\xe1\x85\xdf\xc1\x3c\xb7\x27\x37\x73\xa5\x82\x9f\xeb\x4a\x7a\x7a\x20\x24\xb4\x29\x13\xae\xec\x51\x35\xb7\x71\x99\xf1\x58\xca\x11\x7a\x51\xbe\xa7\x13\x84\xa6\x18\x62\xe8\xaf\x51\x46\x42\x4b\x42\x3a\xef\x55\xcb\xd9\xcc\x27\x38\x1e\x54\x3e\x78\xe0\x26\x88\xef\x5e\xee\x0c\xf5\x7e\xfa\x7a\x2a\x7e\x82\x43\x26\xcb\x36\x5a\xc5\xf1\x83\x16\xc9\xca\xbf\xdb\x01\x51\x5f\xa6\x30\x65\x4b\x4a\xd0\x83\x18\x7e\x26\xd4\xb5\xd2\x96\x24\x0f\xfb\x8c\x3e\xdb\xbe\x6c\x9e\x3a\x14\x21\x9b\xfe\x8d\xdc\xe2\xc1\xcf\xa1\xd6\x64\x4e\x6d\x4f\x24\xa8\xcb\x9f\x56\x17\x29\x45\x64\xb9\xd2\x6f\x08\x91\x62\xda\x5a\x68\x3b\xda\x3a\x8d\x63\x48\x0c\xcb\x05\x4a\xa5\x11\x43\x58\xe0\x95\x87\xf5\x44\xe4\x9f\x4a\x98\x2c\x94\x60\x00\xe6\xe2\x48\x85\xb9\x7d\x25\x47\xe9\xb7\xbe\xad\x46\xa0\xdd\x87\xd3\x6d\x31\x16\x8d\x1a\x6a\xd7\x9f\x62\x97\xe5\xf4\x13\xc2\xdd\xd7\xc2\xb0\x6d\x6c\x4d\x21\x0a\x99\xeb\x35\x14\xad\x1f\xe8\x83\xbf\xae\x78\xde\x99\x8f\xc4\xa6\xc1\xb1\xb5\xde\xef\x72\x51\x40\x37\x92\x6a\xd3\xc3\x51\x0b\x3a\xce\x57\x09\xdd\x7f\xeb\x98\xf2\x7d\x3f\xfd\xbe\x7e\xf3\xf5\xa3\xc6\xe2\x4a\xf9\x55\xc1\xa0\xb0\x80\xa3\xe5\x65\xb9\x90\x97\xef\x2f\xfd\xb8\xdc\x1a\x7f\xa3\x13\xb9\x70\xaa\x9f\xfa\x4c\x01\xfb\x0d\x39\x33\xb2\xc3\xdb\x62\x62\xeb\xcf\xd7\x33\x47\xd3\xfa\xe8\xb8\x33\x1e\x6c\x0d\x5f\x2d\x6c\xfa\xc0\x71\xd9\x74\x4e\x43\x84\x7a\x65\x4d\xa7\x0f\xad\x75\x1a\x0b\xe6\xe4\x9d\xa2\x0c\x40\x8d\xe7\x98\x13\xa3\x97\x62\x75\x8b\x63\x36\x05\x3e\x49\xc1\x2f\x22\x08\x16\x6e\x3c\x5e\x14\xb8\x7a\xc4\xd3\x73\xcf\xab\xfb\xb2\xf3\x9e\x9e\xaf\x02\x5f\x62\xaa\xc4\xa6\x00\xaa\x5f\xbb\x3c\xcb\xb3\x5e\xe1\x79\xd6\xbc\xe8\x8b\x83\x49\xc5\xdd\xb5\xb2\xd2\x63\xa0\x40\x47\x2a\x50\xd1\xf4\x6a\xc9\x36\x2f\x0b\xef\xbd\x6a\x80\xbe\x97\xe5\xf3\x89\x7e\xa1\x39\x6a\x3d\x6e\x81\x22\x96\xc9\xe2\x96\x44\x2d\x9a\x0e\x43\x01\x13\x3c\xf1\x92\x7d\x48\xd3\x8e\x1a\x29\x55\x1e\x9e\x1b\x0d\xbc\xa0\x97\xa2\xa8\x6f\x90\xcc\xe7\xf6\x04\xd2\x62\x3e\xd2\x8b\xce\x5f\x03\x56\xb8\xfa\x4e\x8b\xc7\x66\xef\xd8\x37\x50\x29\xe0\x4e\xcf\x52\xd0\x66\x9e\x9a\x7a\xc5\x82\x9b\x96\xbc\x97\x38\x3d\x46\xde\x26\x0f\xe3\xd4\x47\xbd\xf5\xbe\x8b\x66\x7b\x3e\xbb\x69\x2e\x7a\x66\xb8\x98\xc9\xa5\xee\x03\x6a\x9e\xae\x61\x7a\x2a\xde\xdc\xee\x60\x8a\xe6\x62\xbc\xb9\x50\x6a\xa8\xb5\x9b\x88\xbd\xeb\xd6\x05\x9d\xa7\x63\x15\xaa\xc8\x71\x5d\xd9\x40\x02\xe4\xd2\xf1\x4f\x0a\x85\x69\x4f\xec\x4c\x4b\x3f\x99\x41\x04\x63\xd4\x12\x73\xdf\xde\xa5\x04\x4b\x76\xfe\x1c\xb9\x8c\x5a\x16\xdd\x27\xb4\x3b\x0d\x3a\x93\x23\x9b\xd1\x7e\xd0\x22\x2c\x1c\xa5\x71\xb7\x5c\xaf\x3a\xdd\x3b\x1f\x59\xbd\x58\x4b\x72\x71\xad\xb7\x68\xfb\xe5\x63\x43\x78\x48\x1f\x5c\xd5\xd8\x87\xed\x7c\xae\xe1\xdc\xf1\x81\x84\x09\xf7\xcd\x00\x82\x55\x75\x88\xc8\x97\x44\x02\x63\xc1\x54\x9d\x7d\xa2\x26\xdd\x15\xfe\x60\xe9\x48\x7f\xd7\xa3\xec\xd2\xee\xe3\x6f\x69\xa5\x33\x13\xec\x2e\xae\x6c\x4b\x03\x86\xdd\xa0\x94\xea\xd8\x5c\x15\xd1\x5c\xfc\x22\xee\xa6\xda\xf0\x80\x1d\x11\xab\xc0\x38\x4b\x24\x4a\x3f\x4a\xb6\xf9\xc3\x3b\x4c\x40\x6f\x45\xa3\x95\x74\x0f\x23\xf0\x82\x50\x33\x8b\x32\xac\x2b\x39\x6e\x71\x0d\x42\x16\x84\x4e\x3b\xda\x9d\xfe\xac\x63\xac\xa1\xa6\x5f\xbe\x29\x87\x82\x14\xe3\x59\xfb\x8c\xca\x57\x03\x85\x84\xa4\x4b\x50\xc8\x76\xb8\xb5\x30\x52\x92\xd2\x71\x33\x48\xd6\xe7\xd9\xdf\x9b\x23\xea\x88\xfd\xb5\xed\xf2\x10\x21\x38\x07\xdf\x18\xca\x1a\x0d\xfd\x55\x23\x5a\xd1\xc3\xca\xe2\xec\xdd\x94\x6d\x7e\xb9\x3c\x5a\x68\xd3\x6a\x4c\xf3\x2b\x17\x34\x78\x25\x64\x6f\x0d\xf8\x35\xfe\xa6\x20\xee\x31\x6c\xa7\xa4\x80\x1c\xf5\x77\x7a\x9d\xfb\x0a\x4b\x1a\x13\x1a\x57\x99\xfb\x3e\x62\x59\x10\xfd\x7e\xd6\x12\x54\xbd\x05\x0e\xd6\x93\x69\x01\x95\xde\x09\xc5\xc1\xad\xc4\x9c\xfa\xe8\xc3\x02\x96\x3e\x12\x54\xab\x20\x52\x06\x90\xc3\x59\xf4\x1b\x6f\xdb\xaa\xb5\xf6\xce\xef\x96\xaa\x1b\xec\x0d\x69\x2c\xcc\xfd\x5d\x0b\x6d\x5a\x4c\x0a\x6c\x78\x19\x90\xe7\x0e\x9d\x93\x39\x54\x75\x64\xf0\x53\xff\x54\x5a\x27\x3f\xaf\xb2\x07\x8f\x85\x8d\x68\x09\x12\x00\x6a\xa4\xc2\x03\x9b\xcf\x4e\xa0\x1a\xc5\x73\xd8\xe6\x67\xaa\xad\x00\x0f\x2d\xdc\xcf\x3d\xc6\x87\x9b\x4a\xc7\x54\x88\x79\x1e\x96\x87\xc1\x4f\x94\x2c\xf3\xaa\x7b\xf5\xb5\x9f\x4c\x89\x4b\xbf\x80\x07\x29\x9b\x2c\x9c\xa3\xe6\x29\x28\x86\x9e\x13\x9f\xdd\x8e\xd6\x4d\x3d\x49\x59\xc6\x7a\x4a\xb9\xdd\x95\x12\x82\x62\x6b\xab\x3b\x70\xa0\x7f\x74\xe7\xc3\xe0\x8f\x96\x70\xdf\x9c\x29\xdf\xd7\x65\x6f\x2d\x7a\x17\xc0\x3c\x6b\x28\x3b\x88\x59\xc1\xc4\x43\xcf\x69\x99\x1b\x77\xe7\xae\xe5\xfa\xcb\x79\x7c\x2d\x22\xe8\x88\x1f\x09\x2d\x96\xec\x6c\xc2\x04\x15\x58\x65\x99\x1c\x3c\xa6\x1e\x8d\x31\x88\xea\x0f\xd9\xd2\x48\x38\xb4\x53\xc0\x1f\xa0\x71\xc4\x7e\xa5\x45\xe2\x1d\xc6\x76\xaa\xa4\xe9\x0d\x6f\xfe\xc8\x7c\xe6\x23\x01\x23\x41\x02\x50\x02\x4b\xb9\x97\x5e\x86\xba\x49\x88\x63\x64\x0e\x75\xd3\x0c\xc1\xfb\x65\xe5\x64\xb9\x6a\x23\x3f\xf6\xee\xbe\xc3\x2f\xc4\x30\x23\x11\x64\x7f\x8c\x46\x4b\xee\x1f\xcf\xd2\x68\xe3\xcb\x23\xbd\x88\x00\x5d\x68\xaf\xb3\xc1\xd0\x0c\xea\x7c\xad\xae\x02\xc5\xdc\xf9\x1f\x1d\x11\xa6\x1c\xbf\x49\x72\xab\x29\x3d\x9c\xfb\x82\x88\x81\x59\x3f\x15\x96\x86\x79\xb7\x16\x3c\x02\x14\x77\x8f\x76\x4d\xdc\xb3\x7e\x96\xbe\x6d\xd5\xe7\x6e\x1c\xb9\x1f\x71\xd5\x7e\x96\xa3\x96\x1d\x18\xf6\x6f\x52\x53\x31\x1f\xb8\x5a\xe2\x22\x7e\x27\x9a\x68\xb2\x4b\x79\x17\x30\x6f\xbf\x8c\x94\xcd\x03\x64\x25\x27\x01\x8f\x57\x1a\x53\x0c\x9f\x6c\xdf\x5f\xe7\x1d\xa0\x30\x58\xb3\x1b\x54\x3c\x26\x79\xf3\x9c\xb7\x20\x2a\xaf\x2a\x29\x70\xb5\x7a\xc4\xa7\xc1\x21\xd9\x9b\xa1\x78\x53\x9b\x80\x2a\x82\xe3\x6b\xb5\xd1\x02\x55\x06\xde\x59\x41\xcc\x9d\x36\xfc\x73\xaa\xd3\xaa\xc7\xa9\x5d\x2a\x0a\x01\x12\x8f\xdb\x36\xe1\x6b\x8e\x7b\x5a\x74\x1d\xad\x73\xde\x67\x03\xc7\xe3\xcf\x4d\x24\x39\xf8\xc8\xc5\x52\xf0\x3e\xe9\x04\xc6\x09\x7a\xd9\x81\xf8\xed\x16\x95\x04\xd9\xd4\x64\x9e\x91\xe5\xf3\x17\x33\x68\xc9\x28\x28\x1d\x3b\xcf\x0f\xa5\x4c\xbe\x9b\x2b\xb2\x9b\x9b\x9a\xc5\xd4\x5c\xac\x7c\xa7\x86\x2c\x7c\xd7\x3e\x4d\x94\xc2\xb2\x48\xc6\xe0\x67\xa7\xec\xdd\xcb\x83\xe5\x83\xe2\xd8\xb2\xc8\x51\xe6\x88\x8e\x18\x7f\x23\xc4\xa3\xe6\x6b\x75\xdd\x1b\x51\xd2\x21\xf6\x4a\x2e\xad\xfb\x64\xce\x4d\x81\xd1\xf8\xbb\x2f\xba\xb3\x8d\x97\xab\x0b\x8d\x32\x8c\xcb\x24\xf7\x3f\x53\xf0\x08\x3e\x44\x4c\x39\x9c\x26\x4d\x10\x67\x35\x66\x5d\xef\x1b\x97\xef\x68\xeb\x93\x22\x42\xae\xd2\xf2\x96\xe2\x67\x85\x77\xf3\x70\xa4\x66\x8f\x3b\x5b\x4f\x07\xe2\xb9\x89\x4b\x93\x93\x83\x73\xcf\x03\x30\x08\xfd\x9a\xde\xa8\xe4\x73\xe0\x45\x75\x9d\x93\x8a\x77\x18\x04\x27\x7e\xa1\x44\x96\xfd\x70\x1e\x77\x7a\xb2\xcf\xf1\x5d\xd0\xdb\xa3\xe8\xbc\xc6\xb6\x38\x72\xad\x5a\xa3\x5e\xbb\xdd\x26\xf5\x7c\x4a\xae\x23\x11\x03\xba\xc9\x5b\x7b\xab\xd2\x8c\xd8\x03\x4b\x42\xdf\x3f\xed\x03\xa2\xd7\x01\x31\xd1\x41\x12\xb9\x7e\x85\xd2\xc3\x0f\x3a\xa6\x1b\x64\xc2\x4a\xdb\xf5\x15\x59\x6f\x88\x32\xdb\xd2\x18\xcd\x75\xd0\x9a\x3a\x21\x25\xcb\xe6\x4f\x79\x95\x8f\xb2\x87\x83\x6a\x8d\x97\x38\xb4\xb4\x4a\x58\xb5\xee\x90\x58\x8f\x96\xa9\xfc\x33\x3e\x1b\xaf\xb2\x2c\x91\xe4\x3b\xe6\x13\x52\x66\x0d\xda\x42\x9d\x38\x6a\x75\xbe\x1c\x3c\x5f\xdf\xa3\xa6\xe3\x35\xb6\xa7\x40\xcb\xd2\x1b\x49\xb1\x01\xaf\x5f\x3b\x23\xc6\x57\xa8\x1d\xc3\x84\x96\x57\xb5\x67\x48\x7e\x70\x00\x7a\xc0\x4f\xa8\x3b\x32\x3f\x89\x96\x61\x72\x33\x40\x3c\x6c\x22\xa5\x12\xd0\xaf\x3d\x21\xa3\xd6\xfa\x12\x4a\x49\x59\x98\x56\x34\x7d\x51\x9f\x4e\x0b\xfa\xec\xd5\x14\x34\x36\xe1\xaa\xc9\xb1\x29\x57\xd6\x0f\xe8\xeb\x2c\x09\x88\x65\x86\xcf\xf7\x20\x26\x57\x52\x97\x66\x27\x35\x9e\x40\xd2\xc2\x05\x6e\x5e\xad\xa7\xbc\xf1\x5a\xd2\x74\x9b\x98\xcc\xe1\x83\xb8\x97\xba\xc4\x58\x08\x8d\x44\xb2\xc3\x7b\x48\xb1\xa6\xb3\x79\x2d\x5b\x24\xea\xa5\xf1\x92\xa9\xbc\x98\xce\xa9\x3c\x14\xe4\x1a\x56\x86\x69\x79\x4c\x4d\x73\x21\x69\x05\xa3\xac\xf5\x12\x91\x88\x66\x4d\xc5\x41\x7a\x96\x19\xb0\xdb\xf2\x7c\xcd\x3b\x21\x74\x32\xed\xe9\x41\x7c\xad\xd7\xb7\xc9\xd4\x5f\xa7\xf6\xae\xe2\x5d\x04\x0b\x5f\x33\x93\xe0\x3d\xda\x44\x8b\x9b\xba\x99\x53\xa8\x78\x72\x45\x33\x84\xee\x76\xea\xf9\x70\x09\x49\x42\xd6\xb7\x52\x6a\x38\x61\xbe\x68\x31\xa9\xe7\xa7\x61\x0a\xfa\x4c\x98\xde\x0f\x7c\xa8\x18\x41\xe9\xe7\x33\x88\x1b\xd8\xf7\xc6\xe1\x63\x85\x61\xde\x8d\x60\xce\x6c\xe2\x76\x70\x68\xb8\x14\xee\xeb\x7d\x30\xa8\xe4\xa4\x2e\x72\xd3\x27\x5c\xdb\x42\x49\x7e\xbc\xa3\xbb\x6b\x0a\x62\x0a\xf6\x9b\x6f\xbd\xda\x84\xf5\x19\xaa\x3b\x2e\x1a\x4f\xe1\x4c\x1f\xac\xef\xf6\x2f\x8d\x6b\x53\x55\x7b\x59\x85\xd6\x73\x26\x12\xa0\xf1\xd6\x72\xc7\x4a\x07\x02\x82\x7c\xca\x83\x70\xc4\xd5\x10\x0d\xc1\xe9\x93\xf7\x94\x6f\x8d\xe1\x39\x42\x47\x82\xe7\x49\xd6\xb8\x6c\xd3\x3d\xdc\x2d\xdd\x1b\x6b\xa8\xcf\x91\x23\x1e\x99\x73\xdc\xd7\x5c\xe7\x24\x94\x54\x8c\x6f\xfa\x92\x0e\xb8\x02\x49\xe5\x7c\x1c\xc3\x9e\xc4\x68\x4a\x57\xd7\xdb\x86\x51\x12\x85\x7c\x46\xfc\x1a\xde\xc0\x41\x02\x8f\xb7\x9b\x20\x5e\x54\x40\x86\xa5\xca\x10\x94\xb2\x79\x35\x02\x80\x09\x38\x55\x04\x22\x91\xe4\x2d\x5a\xc6\x31\x3d\xda\xe5\x90\xfd\x1c\x4b\x2e\xb4\x01\x2b\x1d\x2f\x0c\x2a\x98\xca\xe4\x6d\x5a\xf9\x5a\x9d\xb5\x47\xa6\x5c\x06\xc4\xe5\xc7\xc4\x97\x48\x5c\x95\x11\xed\x8c\xed\x8a\x57\x66\x90\x8f\x41\x77\x3d\xfe\x55\xc8\x26\x17\x7d\x55\x75\x1e\xde\x5c\x0d\x7a\xbf\xb8\x2f\xd6\x49\x8f\x81\xda\xdb\xd5\x1c\x76\xd5\xa9\xc9\xd5\x59\x03\x2e\x38\x9f\x1e\x15\xb6\x17\x44\x6c\xa3\xa2\xa2\x4c\xc0\x70\x8f\x93\xaa\x4e\x2d\xce\x30\x89\x55\x16\xb6\x8a\x54\x4b\x24\xdf\x27\x1a\x93\x21\x47\x84\xa2\x11\x49\x71\x4e\x77\x86\x7e\x9e\x5d\x2f\x8e\xf7\x32\xdc\xb0\xc9\xe7\xa0\xc4\xe4\xa7\x82\xc5\x84\x1f\x9f\xe7\x53\x7c\x30\xaa\x7a\x4a\x53\x2b\x84\xf7\x5a\xcc\xc1\xf9\x12\x20\x4d\x2f\xf3\x8b\x88\xda\x49\x74\xcd\x9e\x43\xd6\xf9\xbe\x6b\xa1\xeb\x3a\x31\xfe\x01\x1d\xcc\xf8\x18\x23\x47\x3f\x76\x6f\x39\xcb\x2a\x3d\xd8\x41\xf8\x49\x18\x36\xd1\x3b\x7c\x71\xec\xe8\x09\x9c\xbd\xfb\xa4\xa8\xdf\xec\x09\x1d\x73\x0c\x7c\x76\xa7\x66\x9b\xe4\xec\x1c\x20\xa0\x09\x8f\x36\xc5\x05\x8f\x55\xe7\x66\x98\xbf\xf6\x42\x4f\xe3\xdc\x2d\xa6\x2d\xc4\xa0\xb9\xdb\xeb\xe1\x69\x73\x9c\xaa\x06\xea\xe1\x06\x4c\x46\xab\x54\x34\xb5\xe0\xd1\xe0\x28\xa1\xb5\x47\xa5\xf2\xa1\x84\x73\x5f\x48\xca\x09\x26\xb3\x7c\xbc\xb3\x69\xec\x9a\x13\x81\xc2\x64\xc0\x4c\xe3\x53\xd8\xd5\xbe\x0d\x18\xd5\xd0\xa6\x85\x75\x0c\xa5\x9e\x69\x22\xb6\x75\x2a\xd6\x0e\xa7\xad\x64\x25\x92\x46\xa5\x38\xe8\x6d\x9c\xca\x23\xad\x37\x62\xea\x98\x95\x3f\xc6\xe7\x69\xbd\x1a\x80\x11\xac\x3c\x26\xa8\x6a\x57\x42\x7c\x6c\xa0\x19\x22\xa5\x22\x1b\x42\xfd\x39\x97\xb1\x60\x3d\x80\x47\x13\x4c\x3a\x7c\xff\x6d\x47\x8d\x7f\x62\x90\x8f\xfe\x69\x5f\xe0\x99\x9d\x6d\xf7\x1d\x6d\x31\x3c\xbd\x74\x03\xbe\x10\x4b\xeb\xdd\xed\x3c\x2e\x5f\xce\xd2\xa4\x03\xcd\xeb\x20\xe1\x8b\x1d\xc7\xcb\xc2\x30\x31\xf4\x2c\x3e\xe5\x5d\x6d\x3e\x67\xbe\x31\x83\xa8\x55\x2f\x03\xcb\x1f\x19\xf8\x23\xeb\xc7\x63\xfd\xbe\xd3\xd1\x4e\xf8\xde\x1a\xab\x26\x9e\x3b\xad\xaf\xdd\xb8\x3b\xbe\xdc\x06\x49\x17\x1c\xa9\x93\xf0\xe2\x1d\x02\x83\x85\xc2\x3c\xc2\xd9\xc9\x68\x9a\xb6\x0c\x89\xcb\x1d\xc1\x2a\xcb\x77\x25\x9b\x14\x5f\xbb\xc8\x27\xc2\xdd\x94\x0d\x1a\xd7\x7c\x0e\x96\xc1\x63\xcf\xfb\x2a\x8c\x3e\x9f\x95\x3f\x5f\x31\x98\xe7\x7c\xd4\xba\x62\xad\xe8\x28\xe1\x2d\x12\xb6\x5e\xe3\xcf\xbb\x1e\x12\xdd\x64\x74\xad\x68\x52\x4f\xa0\xd4\x2d\x55\xfb\xf9\xa9\xf0\x61\x43\xb6\x9c\xef\xb1\xa5\x46\xd1\x16\x8f\xa3\x2a\xec\xbc\x46\xf6\xb1\x7e\xe1\x8c\x08\x99\x11\xb9\xf7\xbb\x8d\xcf\xcd\xf6\x35\x6e\x47\x7e\xc5\x51\x14\x2a\x3a\xf1\x75\xa1\x39\x1f\x2d\xb8\x73\x87\x1a\x2e\xef\xb5\xdb\x67\x78\x56\xeb\xc6\x65\xcf\x1c\x01\x9e\xe7\x66\x7d\x86\x86\x4e\x17\xc1\xf8\xeb\x12\xe5\xb0\x5c\x30\xc4\x8b\xe9\x42\x78\x48\x81\xf6\xc2\x36\x7f\xf2\x1e\x28\x53\x03\x5a\x94\xa1\x01\x66\x7d\x7e\xb3\xe6\xe6\x55\x56\xa8\xf2\x9d\xb6\xad\x36\xd9\xa7\x3f\xf6\xc2\xc6\x07\xaa\xb1\xb3\x43\x00\xde\x44\x43\x1c\xdb\xf4\x20\x78\x71\x0c\x08\x97\x6d\x2d\xa4\x49\xd7\xf8\x68\xe4\x26\x89\x1c\x01\xf5\xd5\x02\x8e\xc4\x5e\x97\x46\x44\x14\x99\x5c\x1f\xa9\x13\xdb\x2c\xf0\x9c\x70\x6c\xf5\x0d\xbb\x63\x4b\xdc\xea\x75\xc1\xf5\xfc\xd3\xc9\x96\xa4\x47\xae\x0e\xa1\x1f\x50\x62\x0d\xb3\x10\xe8\x9c\x4d\xdf\x8e\xe7\x76\xe9\xef\xf0\xd5\x69\x00\xf9\x51\xf9\x57\x61\xda\x27\x68\x88\x50\x93\x99\x03\x3f\xdf\x42\xb6\x27\xc8\xa5\xc1\xc7\x2d\x9a\xde\x53\x59\x39\x7b\x4f\x6c\xd5\xcd\xc0\xfc\x5d\x73\x57\x51\xda\x77\x9b\xa7\x85\xbc\xcb\x3b\x14\x2a\xb0\x38\x42\xb6\xd6\xeb\xa0\x90\xba\x83\xce\xec\x72\xfa\x30\x0c\x8f\x69\xe0\x2d\x5d\x94\x00\x19\x3c\xca\xd3\xa8\x65\xfb\x65\x38\x09\x09\xc4\xcd\x44\x30\x8e\x44\x7b\xa7\x47\xf5\xfb\x9b\x10\xd9\x24\xd2\x1c\xac\xe5\x12\x72\x08\xf2\x65\xaa\xbf\xe8\x8f\x59\x04\x59\x38\x6a\x27\x00\x73\x72\x65\x89\x78\xab\x06\xe2\xf8\x0a\x1b\xc7\xc5\x21\x8d\xcc\x88\x50\x56\x8c\xcf\xb3\x17\xd7\xf2\x47\x26\xdb\x59\xbe\x4b\xfd\xe5\xdd\xc4\xb6\x1f\xed\xc5\xde\xcc\xfc\xf3\x4f\xb8\x19\x48\x7a\x27\xb9\x90\x10\x16\x19\x1a\xda\x73\x44\x7c\x98\x6f\x3f\xf8\x3c\x5a\x02\x49\x6b\xc5\x0d\x44\x18\x52\x35\x34\x8d\x0c\xa1\xa6\x5e\xf2\x81\x3c\x19\xab\x02\x39\xa2\x87\x7b\x6c\x18\x98\xa5\x4b\xcc\xd7\x8e\xff\x51\xbb\xf4\xd5\xd2\xaa\x89\x75\x95\xed\x11\x6f\x53\x36\xb1\x8e\x4c\x5f\xea\x15\x8e\x17\xd5\x0f\x7a\xa4\x2f\x87\xc7\xd1\x28\xee\x1c\xe6\x3f\xdc\xb3\x1c\xc8\x3e\xf3\xe5\xed\xe8\x73\xd7\xd7\x4c\xce\x99\x62\xf7\x36\xa4\x90\x7c\xc8\xd6\xb2\xcc\xc9\x2e\x75\xe5\xed\x44\x09\xca\x27\x01\x76\x22\x42\xcb\xc6\xd7\x64\x1b\x6d\x98\xd1\xdf\xe5\xd8\xb8\xcc\x4b\xba\xc2\xdd\x29\x69\x2f\xcb\x90\x15\xca\xe3\x11\xc1\xc3\x9e\x13\xad\x52\xc7\xc3\xe6\x22\x31\x87\x0c\x7d\x7f\xef\x0f\x3a\x63\x10\xbb\x92\x9d\xaf\x34\x74\x23\x50\xbd\x10\xe0\x98\x7c\x3f\xf6\xe3\x1a\xa2\xcb\x20\xae\x5a\x6d\x89\x43\xf7\x0e\x0a\x0a\xda\xfd\x63\xd0\x5a\x91\xa0\xc1\xf3\xfd\xfb\x6f\xb7\x59\xe1\x6b\xa7\xe9\xce\xa2\xcf\x80\xb4\x1a\x4d\x1e\x25\xb0\xcf\xfa\xc2\x45\x27\x92\xec\x2b\x9f\x3b\xaf\x6b\xab\x9a\x29\x3c\x44\x13\x08\x51\x8e\xd2\xc4\xfc\x20\xc3\xe6\xfb\x14\x62\x0d\xf9\x19\x1f\x94\x8f\xe4\xe4\x61\x6d\x04\x65\xc7\xc3\x37\xae\x3f\xc8\x58\xb6\xb3\x2b\xbe\x8c\xc6\x77\x43\x67\xee\xe7\xce\xfb\x28\xe2\x99\x82\x10\x45\xe8\x34\xfb\xac\xe3\x6a\x01\xda\xf0\x1f\x7c\x48\x74\x2f\xe2\x9b\x9a\x64\x0b\xd4\xdf\x05\x18\xbc\x2a\x72\x46\xce\x42\x36\xb6\x9e\x42\x7a\x84\xf9\x56\x0a\xdf\x95\xe1\x7a\x4c\xf0\x24\xf3\x2e\x70\xd8\xda\xd3\x1e\xf2\x4b\xd2\x81\xd2\x38\x91\xea\x6e\xa4\x9e\x0d\x10\x03\x48\x0e\x2a\x4e\x82\x71\x16\x83\xd1\x26\x52\x58\xb1\x58\x4a\x12\x68\xa2\xb7\x49\x16\xc0\x25\xa1\x84\x8a\x17\xee\x7a\x80\xfa\x00\xf1\x40\x57\xa9\x15\x19\x94\x91\xaa\xb1\x80\x5e\x71\x16\xed\xec\xb1\xfc\x92\x5c\x33\x35\xc4\xbc\xbb\x89\xab\xbf\xa0\x7d\xd7\x04\x11\x9a\x78\x5e\xb5\x2a\x5f\xa4\x90\x40\x72\x7b\x4c\x09\x9d\xe8\x84\x85\x9e\x29\xd2\xb5\x5d\x5d\xe9\xdb\xe7\x78\x68\x92\xa7\xc2\x64\x13\x81\x46\x0c\x6b\xed\xc8\x95\xa0\xea\xbb\xcd\x78\x77\xa1\xbd\xe1\xc3\xb6\xed\x1a\x05\x87\x64\x6e\xaa\x2a\xea\x7b\x7a\x14\xbe\x34\xf1\x26\xfd\xf4\x51\xee\xf2\x4a\x95\xb0\x5b\x15\x59\x84\xd8\x5a\xa8\xc3\x39\x74\xb3\xe7\xa7\xbe\x52\x61\x79\xd0\xdd\x83\x56\x7b\x9e\x73\xb2\xb7\x57\x8b\x4b\x42\x90\x4f\xa4\xd3\x58\x6b\xbd\x14\x9e\x8c\x48\x87\xea\x18\x85\x58\xb7\xe2\x5e\x86\xd1\x41\x74\xf2\xe3\xa8\xac\x6c\x41\xac\x82\xfa\xa7\x27\x9c\x66\x43\x49\x8e\x0b\xce\x12\x94\xbe\x84\x43\x9a\x35\x1d\xf0\xc0\xf2\x59\xeb\xec\xe1\xdd\x64\x05\x08\x65\x23\x3a\xad\x26\x2f\x85\x3c\x70\xaa\xa8\xe9\xcd\x55\xd5\x80\x69\xe2\x08\xcc\xad\xe4\xe4\x8e\x14\xc3\x27\x3e\x95\x5f\x69\x35\x9f\x71\x7a\xfb\x8c\xbf\x37\x90\xdc\x06\x39\xae\xda\x10\x64\x3b\xdc\x23\x0b\x00\x5f\x2b\xca\xf5\xf5\x1c\x74\xd4\x74\x93\x08\x2d\x59\xcf\xb3\xda\x26\x2b\x4a\x9d\xb6\x4b\x4a\xbb\xc9\x36\x57\xa4\x14\x1c\x27\x56\xd5\xa1\x16\xe5\x7e\x75\x30\x30\xda\xdd\x2e\xab\x7e\xd3\xba\x78\x85\xf8\xd8\x28\x54\x72\xf8\x8d\x56\x3a\xec\x1c\x9d\x28\xf5\x29\x14\xc1\x05\x5d\x80\x68\x4f\x21\xb4\x6e\x46\xa2\xff\x93\x60\xc1\x3c\xfb\x58\xe1\x89\x86\x54\xde\x8e\x73\x4f\x17\x97\x7f\xa7\x40\xe3\x0d\x41\x82\x7d\x53\x15\x4d\x05\x08\x31\x7f\x10\xbd\x2d\x41\x4a\x92\x1e\x62\x7e\x2c\x2f\x0b\xac\x5a\x84\x64\x44\x8f\x49\x4b\x7c\x80\x34\x44\xbb\xac\x34\x9f\x36\x03\x1c\xe1\x64\xd4\x30\x3d\xd6\x12\x1c\xf4\x68\xfb\x86\x57\xbe\xfd\x8c\xed\x5f\x6b\x39\x65\x60\x13\x23\x0c\xe4\x6e\x70\xf9\xfa\xba\xed\xec\x9b\xff\x69\x2e\x63\x3a\x76\xc6\x79\x96\x22\x8a\xd2\xe2\xc9\xa1\x40\x5e\xaf\xbd\x55\xac\x66\x2e\xa5\x44\x8b\x19\xb3\x97\x67\xf1\x5e\x7b\x61\x74\xa9\x54\xa9\xa4\xe9\xe8\x18\xb6\x47\xa2\x49\xd3\x85\xd8\xd6\x1a\x3f\x2b\xd6\x34\x8a\x97\xec\x31\xc9\x55\x47\xca\xbb\xe9\xb6\x8e\x70\x20\x87\x0a\x9a\xec\x30\x23\xfb\xc0\x78\x3c\x41\xa4\xff\xa4\x1c\xf1\x0a\xdf\xed\x61\x01\x76\x91\x59\x3e\xfb\xa6\xf8\x3e\xca\x23\x34\x38\x62\xb2\xc9\x4a\x7d\xb1\x74\x10\x61\xd0\x33\x8b\x2d\x37\x18\xc3\x11\x41\x4a\xd6\xd9\x38\xaa\x73\xc9\xe2\xad\x02\x96\xe7\x02\xf9\xc9\x99\x64\xe4\x1f\xff\x31\x2a\x96\x65\xd8\x28\x68\x8e\xdd\x15\x3d\xba\x83\x83\x45\x83\xa7\x6e\x26\x42\x86\x70\x64\x19\x87\x43\x3f\x61\xb2\xcf\x86\x15\xb3\x4b\xeb\x08\xc0\x8d\x1d\x12\x3c\x35\x55\x26\xe8\x5a\xa8\xea\x2b\xa8\xe0\xe4\x8e\xff\x44\x3c\x02\xfb\x4b\xc7\x6d\x40\x3e\x66\xec\x5c\x42\x5d\xb4\x3f\x70\xd2\x91\x79\xc9\xbc\x98\x54\x89\x75\x69\xbc\x73\x47\xc1\x49\x4b\x93\xb0\x95\x45\x89\xae\xed\x62\x61\x5d\x77\xec\x60\xc6\x12\x74\xa8\x63\x4c\x77\x19\x91\xbd\xfb\xbd\x71\xea\xd3\x32\x36\x74\xac\x56\x88\x61\xcf\x6a\xc1\x2c\x57\x76\x2a\xf2\x93\xc3\x97\x1f\xcf\xe4\x75\x9c\x54\xe4\x2e\xa2\xcf\x61\x94\x69\x53\x70\x90\x8c\x55\x21\x8e\x96\x85\xa8\xcb\x6f\xd3\x92\x56\xd7\x8b\x33\xfc\x93\xd3\x2d\xbb\x7c\x75\x47\x5f\x35\x89\xdf\x21\x7e\x0b\x11\x5b\xb8\x18\x34\x3f\xec\x43\x30\x0d\xe9\x90\x13\xed\x20\x29\x59\xb2\x76\xcd\xe9\xe2\x3b\xeb\x60\xfb\xe0\x13\x3f\x19\xce\x26\x20\xa0\xea\x7e\x54\x5a\xa0\xe2\xcb\x45\x30\x7c\xbe\x6a\xdb\xc4\xd5\xbf\x10\xbe\x6a\xbf\xc9\x82\x81\x68\x78\x49\xc9\xbb\x03\x1f\xac\x87\xa9\x1c\x07\x55\xce\x70\x5c\x6d\xb7\xee\xfc\x9d\xe1\xee\x70\x21\x78\x46\x0b\xc5\x06\x15\x5a\x31\x2b\x9b\x98\xc6\x67\xff\xa9\xc6\x9c\x86\xf5\x0a\x5d\xfd\xd5\x61\xcc\x2b\x0c\xc4\x26\x0c\x22\xae\xf1\x69\x66\xb6\x7c\x5b\x42\x15\x2a\x2d\x2f\x23\x8f\xdd\x0c\x04\x29\x7a\x45\xc2\xfe\xf4\x06\xe6\xb9\xb3\xc6\x79\xc7\xd5\x2b\x38\xb6\xce\x3d\x36\x4c\xcc\x7c\x55\x8a\xef\x57\x33\x8f\xff\x3f\xd6\xee\xed\x2d\x09\x04\x8d\xe3\xb8\x15\x8b\xe6\xb6\x8a\xe2\x29\x9d\x31\x27\x33\x2c\xb5\x35\x2d\x68\x93\xa4\x44\x1b\x2d\x46\x8b\x52\x59\x8a\x34\x45\x51\x39\xb8\x39\x29\x60\x09\x49\x65\x4d\xc1\x2a\x96\x34\x1e\xc2\x53\x36\x83\x8a\xa6\x42\xd6\x78\xd6\x11\xcb\x54\xc0\x03\x2a\x8a\xd6\x98\xe6\x61\x14\x1b\x49\xc5\x03\xee\x7a\xb3\x97\xb5\x17\xf3\x07\x7c\x9f\xf7\xea\xbd\xfc\x3c\x3f\xf0\x78\x83\x7d\x51\x0a\x43\x94\xb6\xf6\x0e\x8b\x27\x56\x7f\x07\xec\x96\x53\x5d\xf5\xc2\x26\xd2\x39\xbf\xeb\x92\x95\xd2\x5d\xc7\x44\xab\x88\xbe\x7b\xd3\x45\x89\x30\xf1\x2d\x9b\xef\x2f\x68\xb2\x6e\xd9\x26\x8e\x90\x33\x0d\xbe\x01\xc1\x1d\xce\x3a\x99\x93\xe5\xe0\x2a\x13\x58\x08\x76\x98\xa4\xf6\xe4\x0e\x40\xa0\xe4\x23\x93\x26\x48\x93\x1a\x24\xac\x0d\x7a\x00\x9e\x1c\x64\x56\x78\x3c\x85\xaf\xf4\xf8\x23\x20\x77\x04\xb1\xa6\x36\xac\x4f\xf4\x70\x87\x2d\xf2\xfa\x0e\x6f\x57\xcd\xf4\x0c\x9e\xdf\x45\x27\x44\xa4\x40\x1c\xb6\x16\x81\x96\x8b\x0f\x1f\x8b\x62\xea\x06\xca\xca\xc4\xc3\x46\x8c\xb3\x01\x7c\xb7\x33\xc9\x45\x0c\xa2\xcd\xec\x8b\x40\x55\x3c\xd1\x05\xc5\x0b\x3b\x33\xa8\x68\x4a\x7e\xcb\x69\x5b\x64\xaf\x69\x9b\x3e\x2a\xa9\x58\xc1\x3b\xd4\xe8\xc0\x74\x8f\x07\xf4\x64\x83\xa6\x6a\x67\xa4\xb7\x1f\x2f\x25\x99\xb9\x28\x9b\x13\x90\xec\xf9\x43\x35\xb5\x2f\xe1\x55\xac\x3f\xea\xad\xba\x83\xfd\x27\xff\x9d\xfa\x4b\x97\xef\x71\x1b\xce\xa1\x98\xb1\x26\x41\x3b\xb1\x81\x1e\xf5\x5e\x0a\x34\x9c\xbe\x9d\x08\xdb\x55\x4a\x2f\xdc\xe6\x13\xbc\x36\xd8\x82\x8d\x14\x78\xff\x64\xb6\xf2\xd3\x5c\xb6\xad\xee\xce\xf9\xec\xe6\xd4\xae\x6a\x5d\x54\xe1\x83\xb9\xe2\x87\xf7\xe8\xfc\x66\x1c\x31\xfe\x22\x81\xf6\xe3\xc1\x26\x0c\xf0\x5b\x32\x2a\xa5\x96\x86\xdf\x90\x9d\x5e\x0a\x38\xaf\x8b\x11\x4d\x32\x53\xef\x2a\xc4\x11\xc3\x3b\xee\x0c\x6e\x5f\x2f\x20\x64\x54\x73\xa0\x0a\xb6\x71\xdf\xa5\x6b\x18\xed\xc8\xf5\x19\x8d\x8e\x43\x99\x71\xda\x61\x54\xea\x40\xd5\xb6\x5e\x99\xb5\xf8\xea\x25\x2e\x0d\x70\x41\x03\xb2\x24\x29\x12\x43\x5f\xcc\xab\xa4\x5f\x0f\x7d\x62\xea\xf3\x69\x0c\x7a\x55\x38\xd2\xd8\x91\x0e\xfb\x96\xe5\x3f\x19\x6c\xf7\xda\xd9\x2b\xa0\x76\x35\xb3\x05\xcc\x3c\xc9\x98\x5c\xff\x97\xe7\x8a\xaa\x17\xd3\xbb\xf2\x8c\x2c\x2a\x34\xae\x43\x82\x4c\xb7\x89\xce\x71\x70\xf9\x1c\x4f\x68\x5e\x7d\x52\x28\x6d\x6d\x59\xc7\x9d\x96\x1a\xd6\xc3\x4d\x8a\x56\x9e\x86\xe8\xd7\x85\xa7\xf6\x33\xcc\x05\x07\xed\xf2\x2a\x62\x4b\x57\x34\xf6\x40\x71\xc6\x00\x3b\x57\xd9\xdb\x66\x7a\xbf\xd8\x94\x1a\x95\xc1\x1d\x0b\xc6\xa8\xe1\xb8\x12\x97\x9b\x83\xcd\x04\xce\xf6\x10\xe7\x40\xfd\xd3\x0b\xfa\x25\x1a\x05\x2e\x30\x71\xa2\xf1\xbd\x6c\xa1\x2f\x4e\x8b\x77\x3a\x15\x9f\x13\xad\x29\x92\xa9\xe1\x30\x7e\xa8\xf3\x33\x79\x67\x54\x63\xa1\x25\x02\x86\x5b\x73\xf7\xd2\x8c\x36\xfc\xc2\x3b\xb6\xfb\xee\xdb\xff\xfe\xd3\x38\x97\x26\x4d\xff\x9e\xe1\x53\xd9\xfc\x51\xb2\x1e\xb8\x31\x15\xf0\xd5\xce\xe5\x1a\xee\xa9\xcb\x82\x3e\x4e\xb1\xc0\xbc\x33\x28\xc7\xba\x06\x99\xf1\xa8\x7f\x8f\x26\x08\x56\x3f\x56\xef\xf9\x66\x47\xb8\xcb\x35\x34\xc9\x3f\xa8\x79\xe1\x4e\xbb\x7c\xa6\x84\x83\xbf\x0a\x6e\x42\x49\xd0\xb4\xf7\x59\xa7\x47\xa2\x59\x5d\x6d\x12\x90\xb3\xbb\x5b\x8b\xab\x82\x55\x69\x65\xce\xcd\x94\x17\x84\xe4\xda\x96\xf3\xad\xca\xff\x56\xeb\xf1\xbb\x71\xc7\xec\xfe\x7b\xfd\x04\xe0\x7d\x69\x7a\x5e\x42\x96\xe0\xf2\xee\xe2\x05\x23\x1e\xfe\xe2\x03\xd9\xd0\x95\xb8\xb6\xb9\x1e\xf4\x29\x1f\xaf\x78\x23\x23\x20\xc2\xaf\xe7\xd6\x25\xe1\xd0\xdf\x0f\xec\xfd\xb1\xb6\xb4\x5a\x34\x06\x8d\x6b\x41\xcc\xab\x87\x57\xcb\x3b\x40\xbf\x1a\x18\x9c\x34\x6c\x7f\x02\x01\x3b\x04\xa2\x9f\x3d\x3d\xfc\x6a\xe2\x7a\xeb\x9b\xae\x11\xc4\x1e\xc6\x7c\x63\x7d\x72\x6e\x60\xfb\xed\x93\x05\x42\xce\x39\x15\x89\x0a\x56\x48\x0e\xf8\x55\x54\x6d\x19\x15\xb7\x4e\x52\xc3\xec\x7d\xda\x5b\x29\x42\xf2\x36\xcd\x62\xc7\xc0\x7c\x8e\xa5\x99\xeb\xe8\x11\x2a\xed\x30\xf4\x41\x19\x59\x33\x7b\x39\xbf\x5a\xc9\x21\x3d\xce\x51\xf2\x07\x55\xd6\xb8\x52\xe1\x6d\xb9\x35\xfe\x50\x8c\x57\xf8\x93\xe3\x8f\xdf\xb1\x98\x14\xa9\xef\xf8\xb8\x20\x7f\xfa\xa3\xbc\xfd\xd7\x38\x53\xc8\x27\xf0\x07\x4b\x42\x76\xc5\xa5\x53\x3b\x62\x53\x24\xd6\xe1\x3b\x43\x27\x18\x07\xf5\xab\xa5\x4f\x78\x36\xe2\x16\x77\xa2\x5e\x67\x98\xd5\xa1\xa5\x15\x5d\x55\xaa\x9a\x6c\xfc\xe4\xe7\x47\x23\xd1\xf1\xb6\x28\xcc\x09\xc7\xbf\xfc\xe6\xc4\x3e\x17\x37\xa5\xfd\x5a\x95\xa7\xdd\x1f\x99\xd5\x64\x17\xb9\xbc\x75\xde\x67\x64\xf1\x04\xeb\x1b\x83\x20\x10\x3b\xfd\x21\x0d\xed\xcd\x37\xfb\x01\xe2\x73\xfe\xc0\xc3\xa0\xfb\x7c\x12\x88\x48\x52\xc0\xfa\x7b\x28\xab\xc3\x37\xf2\xb4\x49\x11\x5a\x49\x93\xbc\xf9\xb5\xca\xfe\xe6\x27\xb1\x7f\x41\x80\x8b\x70\x77\x37\x30\x33\x02\x2f\x99\x20\x7c\x48\xd2\x60\x2e\xba\xcd\x59\x47\xb7\xee\x6b\xcf\x6f\x98\x97\xe5\x4f\xc5\x3e\x2f\xb5\xca\x45\x78\x32\x1b\xe3\xce\x3c\xe8\xa6\x06\xd5\x85\x8c\x95\x95\x38\x72\x9c\x91\x71\x3c\xfa\xd1\x37\x7b\x2e\x80\x0d\xe7\x00\xa6\x2e\xb9\xa0\x8c\x8c\xd2\x60\x64\x6c\x91\x29\xcd\xa6\xaf\x00\x7b\xcc\xf5\x9f\xf4\xe8\xc4\x9f\x13\xee\x01\xcc\x19\x1a\x0b\x21\x7c\x95\x42\xa8\x28\xcc\xf4\xc8\x7a\x99\x69\x75\x21\x15\x2d\xfa\xed\x21\x3b\x82\x97\x04\x1f\x57\x6e\x5f\x3f\x1a\x06\x03\xa2\x4f\x1c\xba\x86\x0f\x7b\xab\x66\x8e\x06\x77\x02\x8a\xbd\xbb\xc7\x2e\x21\xed\x22\x6f\xb0\x2b\x0c\xf5\xfc\xf6\x55\x0a\x37\x24\x1d\x80\x7e\x5f\x5d\xbf\xdc\x69\xec\xe8\x51\x21\xe8\x55\x4c\xf8\x75\x32\xc9\x11\xc0\xb6\xe3\x9a\x70\xcd\xbd\x13\x66\xa6\x9c\x4e\x80\x42\x19\x49\x09\x75\x8c\xeb\x14\xe1\x96\xb0\xbd\xc9\xa8\xad\x3f\x00\x44\x5b\xdb\x6c\xaf\x40\xd2\xee\xfe\xd5\x54\x55\x09\xb1\x8c\xc9\x8f\x49\xb3\xdb\xb9\x3f\x7a\xd9\x22\x5e\x91\x23\x2b\x6f\xf1\xc5\x44\x5e\x0d\xe7\x60\x0f\xd2\x96\xe4\xd8\xe8\x9b\x06\xac\x7f\xe4\x2a\x5f\x38\x4c\xa3\xb2\xf5\xf4\xf6\x39\x80\xb9\x90\xa8\xd7\x58\x0b\xff\xc9\x06\x89\x3b\xc4\x55\x2e\xde\x15\x0b\xe2\x7e\xe7\x8e\xf3\x75\xc3\xa5\xb3\x44\xe9\x6e\x26\x7b\xd8\x20\xfc\x73\xca\x5e\x2d\x3a\xcd\xcc\xdb\x46\x38\xa7\x24\x7e\x38\x6b\xb9\x7b\x03\x41\x52\x47\x1c\x97\xa1\x5e\x39\xa7\x61\xec\xba\x2d\x1e\xf7\xde\x00\x64\xf6\x62\x86\x8b\x3d\xa0\x15\x25\xa3\x09\x47\x28\xd7\x2e\x72\x1f\xfd\x7f\x0a\xee\x33\x88\xee\x7f\xc5\x97\xd4\xde\x66\x01\xb9\xba\x64\xf0\x65\xbb\xb7\xd9\x7d\xc9\xee\x6d\x16\x7f\x1a\x16\xfc\xcc\xc1\xe7\xd9\x1b\x4c\xbd\xa7\xa3\x5c\xc7\x81\x46\xec\x96\xcd\x45\x4f\x3f\x1f\x7f\xef\x32\xaf\x50\xd6\x7f\x02\x00\x00\xff\xff\x62\xe1\x8f\x8a\x89\x7e\x00\x00")
 )
 
-// binData is a table, holding each asset generator, mapped to its name.
+// binData is a table, holding each logo generator, mapped to its coin.
 var binData = map[string]func() ([]byte, error){
-	"logo.png": logoPNGBytes,
+	"btc": btcLogoPNGBytes,
+	"nmc": nmcLogoPNGBytes,
+	"drk": drkLogoPNGBytes,
+	// Dash is the current name of Darkcoin.
+	"dash": drkLogoPNGBytes,
 }
 
-// Logo loads and returns the logo for the given name.
+// Logo loads and returns the PNG logo of the given coin.
 // It returns an error if the logo could not be found or
 // could not be loaded.
-func Logo(name string) ([]byte, error) {
-	cannonicalName := strings.Replace(name, "\\", "/", -1)
-	if fn, ok := binData[cannonicalName]; ok {
+func Logo(coin string) ([]byte, error) {
+	if fn, ok := binData[strings.ToLower(coin)]; ok {
 		bytes, err := fn()
 		if err != nil {
-			return nil, fmt.Errorf("Logo %s can't read by error: %v", name, err)
+			return nil, fmt.Errorf("Logo %s can't read by error: %v", coin, err)
 		}
 		return bytes, nil
 	}
-	return nil, fmt.Errorf("Logo %s not found", name)
+	return nil, fmt.Errorf("Logo %s not found", coin)
 }
 
-func logoPNGBytes() ([]byte, error) {
-	var data []byte
-	switch conf.CoinType {
-	case "btc", "BTC":
-		data = append(data, btcLogoBytes...)
-	case "nmc", "NMC":
-		data = append(data, nmcLogoBytes...)
-	case "drk", "DRK":
-		data = append(data, drkLogoBytes...)
+// NetworkLogo loads and returns the PNG logo of the coin of net, read
+// from the file named in the coin registry or else built in. Coins
+// without a logo have none.
+func NetworkLogo(net *wallet.Network) ([]byte, error) {
+	if net.Logo != "" {
+		return ioutil.ReadFile(net.Logo)
+	}
+	if _, ok := binData[net.Coin]; !ok {
+		return nil, nil
 	}
-	return binDataRead(
-		data,
-		"logo.png",
-	)
+	return Logo(net.Coin)
+}
+
+func btcLogoPNGBytes() ([]byte, error) {
+	return binDataRead(btcLogoBytes, "btc.png")
+}
+
+func nmcLogoPNGBytes() ([]byte, error) {
+	return binDataRead(nmcLogoBytes, "nmc.png")
+}
+
+func drkLogoPNGBytes() ([]byte, error) {
+	return binDataRead(drkLogoBytes, "drk.png")
 }
 
 func binDataRead(data []byte, name string) ([]byte, error) {
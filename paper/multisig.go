@@ -0,0 +1,98 @@
+// Copyright (C) 2014-15 Michail Kargakis
+// This source code is subject to the terms
+// of the MIT License
+
+package paper
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/kargakis/cryptowallet/wallet"
+
+	pdf "github.com/jung-kurt/gofpdf"
+)
+
+// WriteMultisig renders the private key of each cosigner of ms on its
+// own page followed by a public page with the multisig address, its
+// script and descriptor, and writes the pdf to w. Cosigners whose
+// public keys were given are not in keys and get no page.
+func WriteMultisig(w io.Writer, ms *wallet.Multisig, keys []*wallet.PrivKey, opts *Options) error {
+	paperWallet := pdf.New("P", "mm", "A4", "")
+	if len(opts.Logo) > 0 {
+		logo, err := logoImage(opts.Logo)
+		if err != nil {
+			return err
+		}
+		paperWallet.RegisterImageReader("logo.png", "PNG", bytes.NewReader(logo))
+	}
+	if err := registerQR(paperWallet, "addrCode.jpeg", ms.Address().QR()); err != nil {
+		return err
+	}
+	for i, pk := range keys {
+		if err := addCosignerPage(paperWallet, ms, pk, i); err != nil {
+			return err
+		}
+	}
+	if err := addMultisigPage(paperWallet, ms, opts); err != nil {
+		return err
+	}
+	return paperWallet.Output(w)
+}
+
+// addCosignerPage adds the private key of a cosigner as a new page
+// along with the multisig address it signs for.
+func addCosignerPage(paperWallet *pdf.Fpdf, ms *wallet.Multisig, pk *wallet.PrivKey, index int) error {
+	pkName := fmt.Sprintf("pkCode-%d.jpeg", index)
+	if err := registerQR(paperWallet, pkName, pk.QR()); err != nil {
+		return err
+	}
+
+	paperWallet.AddPage()
+	paperWallet.SetFont("Helvetica", "B", 14.0)
+	tr := paperWallet.UnicodeTranslatorFromDescriptor("") // "" defaults to "cp1252"
+	paperWallet.CellFormat(190, 15, tr(fmt.Sprintf("Cosigner %d of %d", index+1, len(ms.PubKeys()))), "", 1, "C", false, 0, "")
+	paperWallet.SetFont("Helvetica", "B", 10.0)
+	paperWallet.CellFormat(190, 6, tr(multisigTitle(ms)), "", 1, "C", false, 0, "")
+	paperWallet.CellFormat(190, 10, tr(fmt.Sprintf("PrivKey: %s", pk.String())), "", 1, "C", false, 0, "")
+	paperWallet.Image(pkName, 80, 45, 50, 50, false, "JPEG", 0, "")
+	paperWallet.SetXY(10, 100)
+	paperWallet.CellFormat(190, 6, tr(fmt.Sprintf("PubKey: %s", hex.EncodeToString(pk.ECPrivKey().PubKey().SerializeCompressed()))), "", 1, "C", false, 0, "")
+	paperWallet.SetXY(10, 184)
+	addressCells(paperWallet, tr, ms.Address())
+	paperWallet.Image("addrCode.jpeg", 85, 200, 40, 40, false, "JPEG", 0, "")
+	return paperWallet.Error()
+}
+
+// addMultisigPage adds the public page of ms: its address, script,
+// descriptor and the public keys of all cosigners. It holds nothing
+// that can spend the funds.
+func addMultisigPage(paperWallet *pdf.Fpdf, ms *wallet.Multisig, opts *Options) error {
+	paperWallet.AddPage()
+	paperWallet.SetFont("Helvetica", "B", 14.0)
+	tr := paperWallet.UnicodeTranslatorFromDescriptor("") // "" defaults to "cp1252"
+	paperWallet.CellFormat(190, 15, tr(multisigTitle(ms)), "", 1, "C", false, 0, "")
+	paperWallet.SetFont("Helvetica", "B", 10.0)
+	addressCells(paperWallet, tr, ms.Address())
+	paperWallet.Image("addrCode.jpeg", 80, 40, 50, 50, false, "JPEG", 0, "")
+	if len(opts.Logo) > 0 {
+		paperWallet.Image("logo.png", 165, 10, 30, 30, false, "PNG", 0, "")
+	}
+
+	paperWallet.SetFont("Courier", "", 8.0)
+	paperWallet.SetXY(10, 100)
+	text := []string{"Public keys:"}
+	for i, pub := range ms.PubKeys() {
+		text = append(text, fmt.Sprintf("%d. %s", i+1, pub))
+	}
+	text = append(text, "", "Script:", ms.Script(), "", "Descriptor:", ms.Descriptor())
+	paperWallet.MultiCell(190, 5, tr(strings.Join(text, "\n")), "", "L", false)
+	return paperWallet.Error()
+}
+
+func multisigTitle(ms *wallet.Multisig) string {
+	return fmt.Sprintf("%d-of-%d multisig (%s)", ms.Threshold(), len(ms.PubKeys()), ms.Address().Type())
+}
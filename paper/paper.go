@@ -0,0 +1,330 @@
+// Copyright (C) 2014-15 Michail Kargakis
+// This source code is subject to the terms
+// of the MIT License
+
+// Package paper renders private keys and their addresses as pdf
+// paper wallets.
+package paper
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"image"
+	"image/draw"
+	"image/jpeg"
+	"image/png"
+	"io"
+	"strings"
+
+	"github.com/kargakis/cryptowallet/wallet"
+
+	pdf "github.com/jung-kurt/gofpdf"
+	"rsc.io/qr"
+)
+
+const highQuality = 100
+
+// Options control what is printed on a paper wallet.
+type Options struct {
+	// Logo is the PNG image printed on each wallet, usually the Logo
+	// of the coin. No logo is printed when empty.
+	Logo []byte
+	// RedeemScript prints the redeem script of pay-to-script-hash
+	// addresses.
+	RedeemScript bool
+	// XPub prints the account extended public key of mnemonic keys.
+	XPub bool
+	// Layout places the wallets on pages, A4 when nil.
+	Layout *Layout
+	// Keystore prints the keystore of keys of EVM chains.
+	Keystore bool
+	// Chain labels the addresses of EVM chains, which are alike on
+	// all of them. No label is printed when empty.
+	Chain string
+}
+
+var (
+	// ErrNoMnemonicRoom is returned for mnemonic keys printed in
+	// layouts without room for mnemonics.
+	ErrNoMnemonicRoom = errors.New("paper: layout has no room for mnemonics")
+
+	// ErrNoXPubRoom is returned for extended public keys printed in
+	// layouts without room for them.
+	ErrNoXPubRoom = errors.New("paper: layout has no room for extended public keys")
+
+	// ErrNoKeystoreRoom is returned for keys with keystores printed
+	// in layouts without room for keystores.
+	ErrNoKeystoreRoom = errors.New("paper: layout has no room for keystores")
+)
+
+// Write renders the paper wallets of the private keys on the pages of
+// a pdf written to w, as many per page as the layout holds.
+func Write(w io.Writer, keys []*wallet.PrivKey, opts *Options) error {
+	layout := opts.Layout
+	if layout == nil {
+		layout = A4
+	}
+	paperWallet := pdf.New("P", "mm", "A4", "")
+	paperWallet.SetAutoPageBreak(false, 0)
+	if len(opts.Logo) > 0 {
+		logo, err := logoImage(opts.Logo)
+		if err != nil {
+			return err
+		}
+		paperWallet.RegisterImageReader("logo.png", "PNG", bytes.NewReader(logo))
+	}
+	for i, pk := range keys {
+		cell := i % layout.PerPage()
+		if cell == 0 {
+			addLayoutPage(paperWallet, layout)
+		}
+		x, y := layout.origin(cell)
+		if err := addWallet(paperWallet, pk, i, layout, x, y, opts); err != nil {
+			return err
+		}
+	}
+	return paperWallet.Output(w)
+}
+
+// addLayoutPage adds a page of the size of layout with its fold lines.
+func addLayoutPage(paperWallet *pdf.Fpdf, layout *Layout) {
+	paperWallet.AddPageFormat("P", pdf.SizeType{Wd: layout.PageWidth, Ht: layout.PageHeight})
+	if len(layout.Folds) == 0 {
+		return
+	}
+	paperWallet.SetDrawColor(180, 180, 180)
+	paperWallet.SetLineWidth(0.2)
+	for _, y := range layout.Folds {
+		paperWallet.Line(0, y, layout.PageWidth, y)
+	}
+	paperWallet.SetDrawColor(0, 0, 0)
+}
+
+// addWallet adds the paper wallet of pk with its top left corner at
+// (x, y) of the current page. The QR codes are registered under names
+// holding the wallet index, as the pdf caches images by name.
+func addWallet(paperWallet *pdf.Fpdf, pk *wallet.PrivKey, index int, layout *Layout, x, y float64, opts *Options) error {
+	addr := pk.Address()
+	mnemonic := pk.Mnemonic()
+	if mnemonic != "" && !layout.FitsMnemonic() {
+		return ErrNoMnemonicRoom
+	}
+	if opts.XPub && pk.XPub() != "" && !layout.FitsXPub() {
+		return ErrNoXPubRoom
+	}
+	if opts.Keystore && pk.Keystore() != "" && !layout.FitsKeystore() {
+		return ErrNoKeystoreRoom
+	}
+
+	// Create QR code for the private key
+	pkName := fmt.Sprintf("pkCode-%d.jpeg", index)
+	if err := registerQR(paperWallet, pkName, pk.QR()); err != nil {
+		return err
+	}
+
+	// Create QR code for the public address
+	addrName := fmt.Sprintf("addrCode-%d.jpeg", index)
+	if err := registerQR(paperWallet, addrName, addr.QR()); err != nil {
+		return err
+	}
+
+	paperWallet.SetFont("Helvetica", "B", 10.0)
+	tr := paperWallet.UnicodeTranslatorFromDescriptor("") // "" defaults to "cp1252"
+	text := func(t Text, s string) {
+		if t.Width == 0 {
+			return
+		}
+		paperWallet.SetFontSize(t.FontSize)
+		paperWallet.SetXY(x+t.X, y+t.Y)
+		paperWallet.MultiCell(t.Width, t.LineHeight, tr(s), "", "C", false)
+	}
+	img := func(i Image, name, format string) {
+		if i.Size == 0 {
+			return
+		}
+		paperWallet.Image(name, x+i.X, y+i.Y, i.Size, i.Size, false, format, 0, "")
+	}
+
+	label := "PrivKey"
+	if pk.Encrypted() {
+		label = "BIP38 PrivKey"
+	}
+	text(layout.PrivKey, fmt.Sprintf("%s: %s", label, pk.String()))
+	img(layout.PrivKeyQR, pkName, "JPEG")
+	if len(opts.Logo) > 0 {
+		img(layout.Logo, "logo.png", "PNG")
+	}
+	if opts.Chain != "" {
+		text(layout.Address, fmt.Sprintf("Address (%s): %s", opts.Chain, addr.String()))
+	} else {
+		text(layout.Address, fmt.Sprintf("Address: %s", addr.String()))
+	}
+	if addr.CashAddr() != "" {
+		text(layout.CashAddr, fmt.Sprintf("CashAddr: %s", addr.CashAddr()))
+	}
+	img(layout.AddressQR, addrName, "JPEG")
+	// Wallets import the key through its descriptor, which also
+	// applies the BIP86 tweak to taproot keys.
+	if pk.Descriptor() != "" {
+		text(layout.Descriptor, fmt.Sprintf("Descriptor: %s", pk.Descriptor()))
+	}
+	if opts.RedeemScript && addr.RedeemScript() != "" {
+		text(layout.RedeemScript, fmt.Sprintf("Redeem script: %s", addr.RedeemScript()))
+	}
+	if mnemonic != "" {
+		lines := mnemonicLines(mnemonic)
+		if pk.PassphraseProtected() {
+			lines += "\n(protected by a passphrase)"
+		}
+		text(layout.Mnemonic, lines)
+	}
+	if opts.XPub && pk.XPub() != "" {
+		text(layout.XPub, fmt.Sprintf("XPub (%s):\n%s", accountPath(pk.Path()), pk.XPub()))
+	}
+	if opts.Keystore && pk.Keystore() != "" {
+		text(layout.Keystore, fmt.Sprintf("Keystore:\n%s", pk.Keystore()))
+	}
+	return paperWallet.Error()
+}
+
+// registerQR registers a QR code as a JPEG image named name.
+func registerQR(paperWallet *pdf.Fpdf, name string, code image.Image) error {
+	rgba := image.NewRGBA(code.Bounds())
+	draw.Draw(rgba, rgba.Bounds(), code, image.Point{0, 0}, draw.Src)
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, rgba, &jpeg.Options{Quality: highQuality}); err != nil {
+		return err
+	}
+	paperWallet.RegisterImageReader(name, "JPEG", &buf)
+	return paperWallet.Error()
+}
+
+// logoImage redraws the PNG logo on a 900x900 canvas the pdf can
+// embed.
+func logoImage(logoData []byte) ([]byte, error) {
+	logo, err := png.Decode(bytes.NewReader(logoData))
+	if err != nil {
+		return nil, err
+	}
+	logoRGBA := image.NewRGBA(image.Rect(0, 0, 900, 900))
+	draw.Draw(logoRGBA, logoRGBA.Bounds(), logo, image.Point{0, 0}, draw.Src)
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, logoRGBA); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// mnemonicLines numbers the words of a mnemonic and lays them
+// out six per line.
+func mnemonicLines(mnemonic string) string {
+	words := strings.Fields(mnemonic)
+	lines := []string{"Mnemonic:"}
+	for i := 0; i < len(words); i += 6 {
+		var line []string
+		for j := i; j < i+6 && j < len(words); j++ {
+			line = append(line, fmt.Sprintf("%d. %s", j+1, words[j]))
+		}
+		lines = append(lines, strings.Join(line, "   "))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// accountPath trims path down to its deepest hardened element.
+func accountPath(path string) string {
+	parts := strings.Split(path, "/")
+	for i := len(parts) - 1; i > 0; i-- {
+		if strings.HasSuffix(parts[i], "'") || strings.HasSuffix(parts[i], "h") || strings.HasSuffix(parts[i], "H") {
+			return strings.Join(parts[:i+1], "/")
+		}
+	}
+	return parts[0]
+}
+
+// Share is a share of a private key printed on its own page.
+type Share struct {
+	// Mnemonic is the share in words.
+	Mnemonic string
+	// Index of the share from 1 to Count; Threshold shares recover
+	// the private key.
+	Index     int
+	Threshold int
+	Count     int
+}
+
+// WriteShares renders each share of the private key of addr on its own
+// page of a pdf written to w. The private key itself is not printed.
+func WriteShares(w io.Writer, addr *wallet.AddrPubKey, shares []*Share, opts *Options) error {
+	paperWallet := pdf.New("P", "mm", "A4", "")
+	if len(opts.Logo) > 0 {
+		logo, err := logoImage(opts.Logo)
+		if err != nil {
+			return err
+		}
+		paperWallet.RegisterImageReader("logo.png", "PNG", bytes.NewReader(logo))
+	}
+	if err := registerQR(paperWallet, "addrCode.jpeg", addr.QR()); err != nil {
+		return err
+	}
+	for i, share := range shares {
+		if err := addSharePage(paperWallet, addr, share, i, opts); err != nil {
+			return err
+		}
+	}
+	return paperWallet.Output(w)
+}
+
+// addSharePage adds share as a new page along with the address it
+// recovers the private key of.
+func addSharePage(paperWallet *pdf.Fpdf, addr *wallet.AddrPubKey, share *Share, index int, opts *Options) error {
+	code, err := qr.Encode(strings.ToUpper(share.Mnemonic), qr.M)
+	if err != nil {
+		return err
+	}
+	shareName := fmt.Sprintf("shareCode-%d.jpeg", index)
+	if err := registerQR(paperWallet, shareName, wallet.QRImage(code)); err != nil {
+		return err
+	}
+
+	paperWallet.AddPage()
+	paperWallet.SetFont("Helvetica", "B", 14.0)
+	tr := paperWallet.UnicodeTranslatorFromDescriptor("") // "" defaults to "cp1252"
+	paperWallet.CellFormat(190, 15, tr(fmt.Sprintf("Share %d of %d", share.Index, share.Count)), "", 1, "C", false, 0, "")
+	paperWallet.SetFont("Helvetica", "B", 10.0)
+	paperWallet.CellFormat(190, 6, tr(fmt.Sprintf("Any %d of the %d shares recover the private key", share.Threshold, share.Count)), "", 1, "C", false, 0, "")
+	paperWallet.Image(shareName, 70, 35, 70, 70, false, "JPEG", 0, "")
+	paperWallet.SetXY(10, 110)
+	paperWallet.MultiCell(190, 6, tr(shareLines(share.Mnemonic)), "", "C", false)
+	if len(opts.Logo) > 0 {
+		paperWallet.Image("logo.png", 165, 10, 30, 30, false, "PNG", 0, "")
+	}
+	paperWallet.SetXY(10, 184)
+	addressCells(paperWallet, tr, addr)
+	paperWallet.Image("addrCode.jpeg", 85, 200, 40, 40, false, "JPEG", 0, "")
+	return paperWallet.Error()
+}
+
+// addressCells adds a line holding addr, and another holding its
+// CashAddr encoding for coins with CashAddr addresses.
+func addressCells(paperWallet *pdf.Fpdf, tr func(string) string, addr *wallet.AddrPubKey) {
+	paperWallet.CellFormat(190, 6, tr(fmt.Sprintf("Address: %s", addr.String())), "", 1, "C", false, 0, "")
+	if addr.CashAddr() != "" {
+		paperWallet.CellFormat(190, 6, tr(fmt.Sprintf("CashAddr: %s", addr.CashAddr())), "", 1, "C", false, 0, "")
+	}
+}
+
+// shareLines numbers the words of a share mnemonic and lays them out
+// five per line.
+func shareLines(mnemonic string) string {
+	words := strings.Fields(mnemonic)
+	var lines []string
+	for i := 0; i < len(words); i += 5 {
+		var line []string
+		for j := i; j < i+5 && j < len(words); j++ {
+			line = append(line, fmt.Sprintf("%d. %s", j+1, words[j]))
+		}
+		lines = append(lines, strings.Join(line, "   "))
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,45 @@
+// Copyright (C) 2014-15 Michail Kargakis
+// This source code is subject to the terms
+// of the MIT License
+
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"golang.org/x/term"
+)
+
+// readPassphrase prompts for a passphrase on the terminal without
+// echoing it. When confirm is set, the passphrase is asked for twice
+// and must match. Stdin may carry die rolls or shares instead, in
+// which case the passphrase is read from the controlling terminal.
+func readPassphrase(prompt string, confirm bool) string {
+	tty := os.Stdin
+	if !term.IsTerminal(int(tty.Fd())) {
+		if f, err := os.Open("/dev/tty"); err == nil {
+			defer f.Close()
+			tty = f
+		}
+	}
+	fmt.Print(prompt)
+	passphrase, err := term.ReadPassword(int(tty.Fd()))
+	fmt.Println()
+	debug(err, "Cannot read passphrase")
+	if len(passphrase) == 0 {
+		fmt.Println("Passphrase cannot be empty!")
+		os.Exit(1)
+	}
+	if confirm {
+		fmt.Print("Confirm " + prompt)
+		again, err := term.ReadPassword(int(tty.Fd()))
+		fmt.Println()
+		debug(err, "Cannot read passphrase")
+		if string(again) != string(passphrase) {
+			fmt.Println("Passphrases do not match!")
+			os.Exit(1)
+		}
+	}
+	return string(passphrase)
+}
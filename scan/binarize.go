@@ -0,0 +1,127 @@
+// Copyright (C) 2014-15 Michail Kargakis
+// This source code is subject to the terms
+// of the MIT License
+
+package scan
+
+import (
+	"image"
+	"image/color"
+)
+
+const (
+	// blockSize is the side of the blocks whose black points are
+	// estimated.
+	blockSize = 8
+	// minDynamicRange is the smallest luminance range of a block
+	// holding both black and white pixels.
+	minDynamicRange = 24
+)
+
+// bitmap is a binarized image, true for black.
+type bitmap struct {
+	width, height int
+	black         []bool
+}
+
+func (b *bitmap) at(x, y int) bool {
+	if x < 0 || y < 0 || x >= b.width || y >= b.height {
+		return false
+	}
+	return b.black[y*b.width+x]
+}
+
+// luminance returns the gray level of each pixel of img, row by row.
+func luminance(img image.Image) (lum []byte, width, height int) {
+	bounds := img.Bounds()
+	width, height = bounds.Dx(), bounds.Dy()
+	lum = make([]byte, width*height)
+	switch img := img.(type) {
+	case *image.Gray:
+		for y := 0; y < height; y++ {
+			start := img.PixOffset(bounds.Min.X, bounds.Min.Y+y)
+			copy(lum[y*width:], img.Pix[start:start+width])
+		}
+		return lum, width, height
+	case *image.YCbCr:
+		// JPEG photos carry their luminance as is.
+		for y := 0; y < height; y++ {
+			start := img.YOffset(bounds.Min.X, bounds.Min.Y+y)
+			copy(lum[y*width:], img.Y[start:start+width])
+		}
+		return lum, width, height
+	}
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			lum[y*width+x] = color.GrayModel.Convert(img.At(bounds.Min.X+x, bounds.Min.Y+y)).(color.Gray).Y
+		}
+	}
+	return lum, width, height
+}
+
+// binarize thresholds each pixel of img against the average black
+// point of the blocks around it, so that uneven lighting of photos
+// does not wash out parts of a code. Blocks too uniform to hold
+// both black and white take the black point of their neighbors.
+func binarize(img image.Image) *bitmap {
+	lum, width, height := luminance(img)
+	b := &bitmap{width: width, height: height, black: make([]bool, width*height)}
+	bw := (width + blockSize - 1) / blockSize
+	bh := (height + blockSize - 1) / blockSize
+
+	points := make([][]int, bh)
+	for by := 0; by < bh; by++ {
+		points[by] = make([]int, bw)
+		for bx := 0; bx < bw; bx++ {
+			sum, n, min, max := 0, 0, 255, 0
+			for y := by * blockSize; y < (by+1)*blockSize && y < height; y++ {
+				for x := bx * blockSize; x < (bx+1)*blockSize && x < width; x++ {
+					v := int(lum[y*width+x])
+					sum += v
+					n++
+					if v < min {
+						min = v
+					}
+					if v > max {
+						max = v
+					}
+				}
+			}
+			average := sum / n
+			if max-min <= minDynamicRange {
+				// Assume a uniform block is white unless its
+				// neighbors say otherwise.
+				average = min / 2
+				if by > 0 && bx > 0 {
+					neighbors := (points[by-1][bx] + 2*points[by][bx-1] + points[by-1][bx-1]) / 4
+					if min < neighbors {
+						average = neighbors
+					}
+				}
+			}
+			points[by][bx] = average
+		}
+	}
+
+	for by := 0; by < bh; by++ {
+		for bx := 0; bx < bw; bx++ {
+			// Average the black points of the 5x5 blocks around.
+			sum, n := 0, 0
+			for y := by - 2; y <= by+2; y++ {
+				for x := bx - 2; x <= bx+2; x++ {
+					if y >= 0 && y < bh && x >= 0 && x < bw {
+						sum += points[y][x]
+						n++
+					}
+				}
+			}
+			threshold := sum / n
+			for y := by * blockSize; y < (by+1)*blockSize && y < height; y++ {
+				for x := bx * blockSize; x < (bx+1)*blockSize && x < width; x++ {
+					b.black[y*width+x] = int(lum[y*width+x]) <= threshold
+				}
+			}
+		}
+	}
+	return b
+}
@@ -0,0 +1,304 @@
+// Copyright (C) 2014-15 Michail Kargakis
+// This source code is subject to the terms
+// of the MIT License
+
+package scan
+
+import (
+	"fmt"
+	"math/bits"
+	"strings"
+	"unicode/utf8"
+
+	"rsc.io/qr/coding"
+)
+
+const (
+	// formatMask is XORed with the format information so that it is
+	// never all white.
+	formatMask = 0x5412
+	formatPoly = 0x537
+	// maxFormatErrors is the most bit errors the format BCH code
+	// corrects.
+	maxFormatErrors = 3
+
+	alphanumeric = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZ $%*+-./:"
+)
+
+// Data segment modes.
+const (
+	modeTerminator     = 0
+	modeNumeric        = 1
+	modeAlphanumeric   = 2
+	modeStructuredJoin = 3
+	modeByte           = 4
+	modeECI            = 7
+)
+
+// decodeModules decodes the text of a code from its modules, true
+// for black.
+func decodeModules(modules [][]bool) (string, error) {
+	size := len(modules)
+	v := coding.Version((size - 17) / 4)
+	if (size-17)%4 != 0 || v < coding.MinVersion || v > coding.MaxVersion {
+		return "", ErrCorrupt
+	}
+	level, mask, err := readFormat(modules, v)
+	if err != nil {
+		return "", err
+	}
+	plan, err := coding.NewPlan(v, level, mask)
+	if err != nil {
+		return "", err
+	}
+
+	// The plan maps each data and check module to its bit, blocks
+	// already laid out one after the other.
+	stream := make([]byte, plan.DataBytes+plan.CheckBytes)
+	for y, row := range plan.Pixel {
+		for x, pix := range row {
+			if r := pix.Role(); r != coding.Data && r != coding.Check {
+				continue
+			}
+			// Black marks the color of a zero bit after masking.
+			if modules[y][x] != (pix&coding.Black != 0) {
+				o := pix.Offset()
+				stream[o/8] |= 1 << (7 - o&7)
+			}
+		}
+	}
+
+	blocks := plan.Blocks
+	check := plan.CheckBytes / blocks
+	short := plan.DataBytes / blocks
+	extra := plan.DataBytes % blocks
+	var data []byte
+	offset := 0
+	for i := 0; i < blocks; i++ {
+		n := short
+		if i >= blocks-extra {
+			n++
+		}
+		codeword := append(append([]byte{}, stream[offset:offset+n]...),
+			stream[plan.DataBytes+i*check:plan.DataBytes+(i+1)*check]...)
+		if err := correct(codeword, check); err != nil {
+			return "", err
+		}
+		data = append(data, codeword[:n]...)
+		offset += n
+	}
+	return parseSegments(data, v)
+}
+
+// readFormat reads the error correction level and mask from either
+// copy of the format information, correcting bit errors.
+func readFormat(modules [][]bool, v coding.Version) (coding.Level, coding.Mask, error) {
+	plan, err := coding.NewPlan(v, coding.L, 0)
+	if err != nil {
+		return 0, 0, err
+	}
+	// One copy is around the top left finder pattern, the other
+	// split between the other two.
+	var copies [2]uint32
+	for y, row := range plan.Pixel {
+		for x, pix := range row {
+			if pix.Role() != coding.Format || !modules[y][x] {
+				continue
+			}
+			c := 1
+			if x < 9 && y < 9 {
+				c = 0
+			}
+			copies[c] |= 1 << pix.Offset()
+		}
+	}
+	best, bestErrors := 0, maxFormatErrors+1
+	for format := 0; format < 32; format++ {
+		code := formatCode(format) ^ formatMask
+		for _, c := range copies {
+			if n := bits.OnesCount32(c ^ code); n < bestErrors {
+				best, bestErrors = format, n
+			}
+		}
+	}
+	if bestErrors > maxFormatErrors {
+		return 0, 0, ErrCorrupt
+	}
+	// The level is stored as L=01, M=00, Q=11, H=10.
+	return coding.Level(best>>3 ^ 1), coding.Mask(best & 7), nil
+}
+
+// formatCode appends the BCH check bits to the five bits of format.
+func formatCode(format int) uint32 {
+	code := uint32(format) << 10
+	rem := code
+	for i := 14; i >= 10; i-- {
+		if rem&(1<<uint(i)) != 0 {
+			rem ^= formatPoly << uint(i-10)
+		}
+	}
+	return code | rem
+}
+
+// bitReader reads big-endian bit fields.
+type bitReader struct {
+	data []byte
+	pos  int
+}
+
+func (r *bitReader) left() int { return 8*len(r.data) - r.pos }
+
+func (r *bitReader) read(n int) int {
+	v := 0
+	for i := 0; i < n; i++ {
+		v <<= 1
+		if r.data[r.pos/8]&(1<<uint(7-r.pos%8)) != 0 {
+			v |= 1
+		}
+		r.pos++
+	}
+	return v
+}
+
+// countBits returns the size of the character count of a segment in
+// mode for version v.
+func countBits(mode int, v coding.Version) int {
+	class := 0
+	switch {
+	case v >= 27:
+		class = 2
+	case v >= 10:
+		class = 1
+	}
+	switch mode {
+	case modeNumeric:
+		return [3]int{10, 12, 14}[class]
+	case modeAlphanumeric:
+		return [3]int{9, 11, 13}[class]
+	default:
+		return [3]int{8, 16, 16}[class]
+	}
+}
+
+// parseSegments decodes the numeric, alphanumeric and byte segments
+// of data. Byte segments are taken as UTF-8, or Latin-1 when they are
+// not valid UTF-8.
+func parseSegments(data []byte, v coding.Version) (string, error) {
+	r := &bitReader{data: data}
+	var text strings.Builder
+	for r.left() >= 4 {
+		mode := r.read(4)
+		switch mode {
+		case modeTerminator:
+			return text.String(), nil
+		case modeECI:
+			// Only the default character sets are supported, skip
+			// the designator.
+			if r.left() < 8 {
+				return "", ErrCorrupt
+			}
+			if d := r.read(8); d&0x80 != 0 {
+				skip := 8
+				if d&0x40 != 0 {
+					skip = 16
+				}
+				if r.left() < skip {
+					return "", ErrCorrupt
+				}
+				r.read(skip)
+			}
+			continue
+		case modeStructuredJoin:
+			if r.left() < 16 {
+				return "", ErrCorrupt
+			}
+			r.read(16)
+			continue
+		case modeNumeric, modeAlphanumeric, modeByte:
+		default:
+			return "", ErrUnsupported
+		}
+		if r.left() < countBits(mode, v) {
+			return "", ErrCorrupt
+		}
+		count := r.read(countBits(mode, v))
+		var err error
+		switch mode {
+		case modeNumeric:
+			err = readNumeric(r, &text, count)
+		case modeAlphanumeric:
+			err = readAlphanumeric(r, &text, count)
+		case modeByte:
+			err = readBytes(r, &text, count)
+		}
+		if err != nil {
+			return "", err
+		}
+	}
+	return text.String(), nil
+}
+
+func readNumeric(r *bitReader, text *strings.Builder, count int) error {
+	for count > 0 {
+		digits, size := 3, 10
+		switch count {
+		case 1:
+			digits, size = 1, 4
+		case 2:
+			digits, size = 2, 7
+		}
+		if r.left() < size {
+			return ErrCorrupt
+		}
+		s := fmt.Sprintf("%0*d", digits, r.read(size))
+		if len(s) > digits {
+			return ErrCorrupt
+		}
+		text.WriteString(s)
+		count -= digits
+	}
+	return nil
+}
+
+func readAlphanumeric(r *bitReader, text *strings.Builder, count int) error {
+	for ; count >= 2; count -= 2 {
+		if r.left() < 11 {
+			return ErrCorrupt
+		}
+		n := r.read(11)
+		if n >= 45*45 {
+			return ErrCorrupt
+		}
+		text.WriteByte(alphanumeric[n/45])
+		text.WriteByte(alphanumeric[n%45])
+	}
+	if count == 1 {
+		if r.left() < 6 {
+			return ErrCorrupt
+		}
+		n := r.read(6)
+		if n >= 45 {
+			return ErrCorrupt
+		}
+		text.WriteByte(alphanumeric[n])
+	}
+	return nil
+}
+
+func readBytes(r *bitReader, text *strings.Builder, count int) error {
+	if r.left() < 8*count {
+		return ErrCorrupt
+	}
+	b := make([]byte, count)
+	for i := range b {
+		b[i] = byte(r.read(8))
+	}
+	if utf8.Valid(b) {
+		text.Write(b)
+		return nil
+	}
+	for _, c := range b {
+		text.WriteRune(rune(c))
+	}
+	return nil
+}
@@ -0,0 +1,201 @@
+// Copyright (C) 2014-15 Michail Kargakis
+// This source code is subject to the terms
+// of the MIT License
+
+package scan
+
+import "math"
+
+// point is a location in an image.
+type point struct {
+	x, y float64
+}
+
+func distance(a, b point) float64 {
+	return math.Hypot(a.x-b.x, a.y-b.y)
+}
+
+// pattern is a finder or alignment pattern located in an image.
+type pattern struct {
+	point
+	// module is the estimated size of a module in pixels.
+	module float64
+	// count is the number of scans that found the pattern.
+	count int
+}
+
+// run is a run of pixels of the same color along a row or column.
+type run struct {
+	black  bool
+	start  int
+	length int
+}
+
+// rowRuns returns the runs of row y between x0 and x1.
+func (b *bitmap) rowRuns(y, x0, x1 int) []run {
+	var runs []run
+	for x := x0; x < x1; {
+		black := b.at(x, y)
+		start := x
+		for x < x1 && b.at(x, y) == black {
+			x++
+		}
+		runs = append(runs, run{black: black, start: start, length: x - start})
+	}
+	return runs
+}
+
+// finderRatio reports whether the five runs of counts have the
+// 1:1:3:1:1 proportions of a finder pattern.
+func finderRatio(counts [5]int) bool {
+	total := 0
+	for _, c := range counts {
+		if c == 0 {
+			return false
+		}
+		total += c
+	}
+	if total < 7 {
+		return false
+	}
+	module := float64(total) / 7
+	variance := module / 2
+	return math.Abs(module-float64(counts[0])) < variance &&
+		math.Abs(module-float64(counts[1])) < variance &&
+		math.Abs(3*module-float64(counts[2])) < 3*variance &&
+		math.Abs(module-float64(counts[3])) < variance &&
+		math.Abs(module-float64(counts[4])) < variance
+}
+
+// crossCheck counts the five runs of a finder pattern through (x, y)
+// along the direction (dx, dy), (x, y) being within its center, and
+// returns the offset of the center of the pattern from (x, y) along
+// that direction and the total length of the runs. It gives up when
+// a run grows longer than maxCount.
+func (b *bitmap) crossCheck(x, y, dx, dy, maxCount int) (center float64, total int, ok bool) {
+	var counts [5]int
+	// Back from the center through the white and black rings.
+	i := 0
+	for ; b.at(x-i*dx, y-i*dy) && inside(b, x-i*dx, y-i*dy); i++ {
+		counts[2]++
+	}
+	if !inside(b, x-i*dx, y-i*dy) {
+		return 0, 0, false
+	}
+	for ; !b.at(x-i*dx, y-i*dy) && inside(b, x-i*dx, y-i*dy) && counts[1] <= maxCount; i++ {
+		counts[1]++
+	}
+	if !inside(b, x-i*dx, y-i*dy) || counts[1] > maxCount {
+		return 0, 0, false
+	}
+	for ; b.at(x-i*dx, y-i*dy) && counts[0] <= maxCount; i++ {
+		counts[0]++
+	}
+	if counts[0] > maxCount {
+		return 0, 0, false
+	}
+	back := counts[2]
+
+	// Forward through the rest of the center and the rings.
+	j := 1
+	for ; b.at(x+j*dx, y+j*dy) && inside(b, x+j*dx, y+j*dy); j++ {
+		counts[2]++
+	}
+	if !inside(b, x+j*dx, y+j*dy) {
+		return 0, 0, false
+	}
+	for ; !b.at(x+j*dx, y+j*dy) && inside(b, x+j*dx, y+j*dy) && counts[3] <= maxCount; j++ {
+		counts[3]++
+	}
+	if !inside(b, x+j*dx, y+j*dy) || counts[3] > maxCount {
+		return 0, 0, false
+	}
+	for ; b.at(x+j*dx, y+j*dy) && counts[4] <= maxCount; j++ {
+		counts[4]++
+	}
+	if counts[4] > maxCount || !finderRatio(counts) {
+		return 0, 0, false
+	}
+	for _, c := range counts {
+		total += c
+	}
+	// The center run spans from -(back-1) to the first white pixel
+	// ahead of (x, y).
+	first := -(back - 1)
+	center = float64(first) + float64(counts[2])/2 - 0.5
+	return center, total, true
+}
+
+func inside(b *bitmap, x, y int) bool {
+	return x >= 0 && y >= 0 && x < b.width && y < b.height
+}
+
+// findFinderPatterns scans the rows of b for the 1:1:3:1:1 runs of
+// finder patterns, confirms each along the column and the row through
+// its center and merges repeated findings of the same pattern.
+func (b *bitmap) findFinderPatterns() []*pattern {
+	var found []*pattern
+	for y := 0; y < b.height; y++ {
+		runs := b.rowRuns(y, 0, b.width)
+		for i := 0; i+4 < len(runs); i++ {
+			if !runs[i].black {
+				continue
+			}
+			var counts [5]int
+			for k := range counts {
+				counts[k] = runs[i+k].length
+			}
+			if !finderRatio(counts) {
+				continue
+			}
+			hTotal := 0
+			for _, c := range counts {
+				hTotal += c
+			}
+			cx := runs[i+2].start + runs[i+2].length/2
+
+			// Confirm along the column, then again along the row
+			// through the refined center.
+			dy, vTotal, ok := b.crossCheck(cx, y, 0, 1, counts[2])
+			if !ok || 5*abs(vTotal-hTotal) >= 2*hTotal {
+				continue
+			}
+			cy := float64(y) + dy
+			dx, hTotal2, ok := b.crossCheck(cx, int(cy+0.5), 1, 0, counts[2])
+			if !ok || 5*abs(hTotal2-hTotal) >= 2*hTotal {
+				continue
+			}
+			p := &pattern{
+				point:  point{float64(cx) + dx + 0.5, cy + 0.5},
+				module: float64(hTotal2+vTotal) / 14,
+				count:  1,
+			}
+			found = mergePattern(found, p)
+		}
+	}
+	return found
+}
+
+// mergePattern adds p to patterns, averaging it into a pattern found
+// before at about the same place and of about the same size.
+func mergePattern(patterns []*pattern, p *pattern) []*pattern {
+	for _, q := range patterns {
+		if math.Abs(p.x-q.x) <= q.module && math.Abs(p.y-q.y) <= q.module &&
+			math.Abs(p.module-q.module) <= math.Max(1, q.module/4) {
+			n := float64(q.count)
+			q.x = (q.x*n + p.x) / (n + 1)
+			q.y = (q.y*n + p.y) / (n + 1)
+			q.module = (q.module*n + p.module) / (n + 1)
+			q.count++
+			return patterns
+		}
+	}
+	return append(patterns, p)
+}
+
+func abs(x int) int {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
@@ -0,0 +1,150 @@
+// Copyright (C) 2014-15 Michail Kargakis
+// This source code is subject to the terms
+// of the MIT License
+
+package scan
+
+import (
+	"math"
+	"sort"
+)
+
+// transform is a perspective transform mapping module coordinates of
+// a code to image coordinates.
+type transform [8]float64
+
+// newTransform returns the transform mapping each of the points src
+// to the point of dst at the same index.
+func newTransform(src, dst [4]point) (transform, bool) {
+	// Solve the eight equations
+	//	x' = (a x + b y + c) / (g x + h y + 1)
+	//	y' = (d x + e y + f) / (g x + h y + 1)
+	// for the coefficients a to h.
+	var m [8][9]float64
+	for i := 0; i < 4; i++ {
+		x, y, u, v := src[i].x, src[i].y, dst[i].x, dst[i].y
+		m[2*i] = [9]float64{x, y, 1, 0, 0, 0, -u * x, -u * y, u}
+		m[2*i+1] = [9]float64{0, 0, 0, x, y, 1, -v * x, -v * y, v}
+	}
+	for col := 0; col < 8; col++ {
+		pivot := col
+		for row := col + 1; row < 8; row++ {
+			if math.Abs(m[row][col]) > math.Abs(m[pivot][col]) {
+				pivot = row
+			}
+		}
+		if math.Abs(m[pivot][col]) < 1e-12 {
+			return transform{}, false
+		}
+		m[col], m[pivot] = m[pivot], m[col]
+		for row := 0; row < 8; row++ {
+			if row == col {
+				continue
+			}
+			f := m[row][col] / m[col][col]
+			for k := col; k < 9; k++ {
+				m[row][k] -= f * m[col][k]
+			}
+		}
+	}
+	var t transform
+	for i := range t {
+		t[i] = m[i][8] / m[i][i]
+	}
+	return t, true
+}
+
+func (t transform) apply(x, y float64) point {
+	w := t[6]*x + t[7]*y + 1
+	return point{(t[0]*x + t[1]*y + t[2]) / w, (t[3]*x + t[4]*y + t[5]) / w}
+}
+
+// sample reads the modules of a code of the given size through t,
+// true for black.
+func (b *bitmap) sample(t transform, size int) [][]bool {
+	modules := make([][]bool, size)
+	for y := range modules {
+		modules[y] = make([]bool, size)
+		for x := range modules[y] {
+			p := t.apply(float64(x)+0.5, float64(y)+0.5)
+			modules[y][x] = b.at(int(math.Floor(p.x)), int(math.Floor(p.y)))
+		}
+	}
+	return modules
+}
+
+// findAlignments looks for alignment patterns, black modules in a
+// white ring in a black ring, within radius pixels of est. Data
+// modules may look alike, so there may be several: those found by
+// more rows and closer to est come first.
+func (b *bitmap) findAlignments(est point, module, radius float64) []*pattern {
+	x0 := int(math.Max(0, est.x-radius))
+	x1 := int(math.Min(float64(b.width), est.x+radius))
+	y0 := int(math.Max(0, est.y-radius))
+	y1 := int(math.Min(float64(b.height), est.y+radius))
+	var found []*pattern
+	for y := y0; y < y1; y++ {
+		runs := b.rowRuns(y, x0, x1)
+		for i := 1; i+1 < len(runs); i++ {
+			if !runs[i].black || !moduleRuns(module, runs[i-1].length, runs[i].length, runs[i+1].length) {
+				continue
+			}
+			cx := runs[i].start + runs[i].length/2
+			cy, ok := b.alignmentColumn(cx, y, module)
+			if !ok {
+				continue
+			}
+			p := point{float64(runs[i].start) + float64(runs[i].length)/2, cy + 0.5}
+			if distance(p, est) <= radius {
+				found = mergePattern(found, &pattern{point: p, module: module, count: 1})
+			}
+		}
+	}
+	score := func(p *pattern) float64 { return (distance(p.point, est) + module) / float64(p.count) }
+	sort.Slice(found, func(i, j int) bool { return score(found[i]) < score(found[j]) })
+	return found
+}
+
+// alignmentColumn checks that the column through (x, y) crosses the
+// center of an alignment pattern and returns the row of its center.
+func (b *bitmap) alignmentColumn(x, y int, module float64) (float64, bool) {
+	up := 0
+	for b.at(x, y-up-1) {
+		up++
+	}
+	down := 0
+	for b.at(x, y+down+1) {
+		down++
+	}
+	white := func(from, dir int) int {
+		n := 0
+		for inside(b, x, from+dir*(n+1)) && !b.at(x, from+dir*(n+1)) {
+			n++
+		}
+		return n
+	}
+	if !moduleRuns(module, white(y-up, -1), up+down+1, white(y+down, 1)) {
+		return 0, false
+	}
+	return float64(y) + float64(down-up)/2, true
+}
+
+// moduleRuns reports whether runs of pixels are about as long as
+// each other and as a module. Rotated codes have runs along rows and
+// columns up to half as long again as their modules.
+func moduleRuns(module float64, runs ...int) bool {
+	mean := 0.0
+	for _, n := range runs {
+		mean += float64(n)
+	}
+	mean /= float64(len(runs))
+	if mean < module/2 || mean > 2*module {
+		return false
+	}
+	for _, n := range runs {
+		if math.Abs(float64(n)-mean) >= mean/2 {
+			return false
+		}
+	}
+	return true
+}
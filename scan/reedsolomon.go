@@ -0,0 +1,119 @@
+// Copyright (C) 2014-15 Michail Kargakis
+// This source code is subject to the terms
+// of the MIT License
+
+package scan
+
+import "rsc.io/qr/coding"
+
+var field = coding.Field
+
+// correct fixes the errors of a Reed-Solomon codeword whose last
+// check bytes are its error correction, as QR codes use it: the roots
+// of the generator polynomial are the first check powers of α. It
+// returns ErrCorrupt when there are more errors than it can fix.
+func correct(codeword []byte, check int) error {
+	n := len(codeword)
+	// Syndromes: the codeword evaluated at the roots.
+	syndromes := make([]byte, check)
+	clean := true
+	for j := range syndromes {
+		x := field.Exp(j)
+		var s byte
+		for _, c := range codeword {
+			s = field.Mul(s, x) ^ c
+		}
+		syndromes[j] = s
+		clean = clean && s == 0
+	}
+	if clean {
+		return nil
+	}
+
+	// Berlekamp-Massey finds the error locator polynomial, lowest
+	// degree first.
+	locator := []byte{1}
+	prev := []byte{1}
+	numErrors, shift, prevDiscrepancy := 0, 1, byte(1)
+	for k := 0; k < check; k++ {
+		d := syndromes[k]
+		for i := 1; i <= numErrors && i < len(locator); i++ {
+			d ^= field.Mul(locator[i], syndromes[k-i])
+		}
+		if d == 0 {
+			shift++
+			continue
+		}
+		scale := field.Mul(d, field.Inv(prevDiscrepancy))
+		next := make([]byte, max(len(locator), len(prev)+shift))
+		copy(next, locator)
+		for i, p := range prev {
+			next[i+shift] ^= field.Mul(scale, p)
+		}
+		if 2*numErrors <= k {
+			prev, locator = locator, next
+			numErrors = k + 1 - numErrors
+			prevDiscrepancy = d
+			shift = 1
+		} else {
+			locator = next
+			shift++
+		}
+	}
+	if 2*numErrors > check {
+		return ErrCorrupt
+	}
+
+	// The error evaluator is the product of the syndrome and locator
+	// polynomials modulo x^check.
+	evaluator := make([]byte, check)
+	for i, s := range syndromes {
+		for j, l := range locator {
+			if i+j < check {
+				evaluator[i+j] ^= field.Mul(s, l)
+			}
+		}
+	}
+
+	// Chien search for the locations, Forney for the magnitudes. The
+	// byte at index i is the coefficient of x^(n-1-i).
+	found := 0
+	for i := 0; i < n; i++ {
+		power := n - 1 - i
+		xInv := field.Exp(255 - power%255)
+		if eval(locator, xInv) != 0 {
+			continue
+		}
+		// The formal derivative keeps the odd coefficients.
+		var derivative byte
+		for j := 1; j < len(locator); j += 2 {
+			derivative ^= field.Mul(locator[j], field.Exp((255-power%255)*(j-1)%255))
+		}
+		if derivative == 0 {
+			return ErrCorrupt
+		}
+		magnitude := field.Mul(field.Exp(power), field.Mul(eval(evaluator, xInv), field.Inv(derivative)))
+		codeword[i] ^= magnitude
+		found++
+	}
+	if found != numErrors {
+		return ErrCorrupt
+	}
+	return nil
+}
+
+// eval evaluates the polynomial p, lowest degree first, at x.
+func eval(p []byte, x byte) byte {
+	var y byte
+	for i := len(p) - 1; i >= 0; i-- {
+		y = field.Mul(y, x) ^ p[i]
+	}
+	return y
+}
+
+func max(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,291 @@
+// Copyright (C) 2014-15 Michail Kargakis
+// This source code is subject to the terms
+// of the MIT License
+
+// Package scan locates and decodes QR codes in images, such as photos
+// or scans of printed paper wallets.
+package scan
+
+import (
+	"errors"
+	"image"
+	_ "image/jpeg" // register JPEG decoding
+	_ "image/png"  // register PNG decoding
+	"math"
+	"os"
+	"sort"
+)
+
+var (
+	// ErrNotFound is returned for images without a readable QR code.
+	ErrNotFound = errors.New("scan: no QR code found")
+
+	// ErrCorrupt is returned for codes with more errors than their
+	// error correction fixes.
+	ErrCorrupt = errors.New("scan: QR code too damaged to decode")
+
+	// ErrUnsupported is returned for codes holding kanji or other
+	// data modes that are not supported.
+	ErrUnsupported = errors.New("scan: unsupported QR code data mode")
+)
+
+const (
+	// maxPatterns bounds the finder patterns tried in groups of
+	// three.
+	maxPatterns = 30
+	// maxSkew bounds the ratio of the distances from the top left
+	// finder pattern to the other two, and of their module sizes.
+	maxSkew = 1.5
+	// maxCos bounds the cosine of the angle at the top left finder
+	// pattern, ideally square.
+	maxCos = 0.5
+	// alignmentRadius bounds the distance in modules between the
+	// alignment pattern and where it would be without perspective.
+	alignmentRadius = 12
+	// maxAlignments bounds the alignment pattern candidates tried.
+	maxAlignments = 4
+)
+
+// DecodeFile decodes the QR codes of the PNG or JPEG image in the
+// named file.
+func DecodeFile(name string) ([]string, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	img, _, err := image.Decode(f)
+	if err != nil {
+		return nil, err
+	}
+	return Decode(img)
+}
+
+// Decode returns the text of each QR code found in img, from top to
+// bottom and left to right. It returns ErrNotFound when no code can
+// be read.
+func Decode(img image.Image) ([]string, error) {
+	b := binarize(img)
+	patterns := b.findFinderPatterns()
+	// Patterns found by a single scan are likely noise when there
+	// are enough others.
+	var strong []*pattern
+	for _, p := range patterns {
+		if p.count > 1 {
+			strong = append(strong, p)
+		}
+	}
+	if len(strong) >= 3 {
+		patterns = strong
+	}
+	sort.SliceStable(patterns, func(i, j int) bool { return patterns[i].count > patterns[j].count })
+	if len(patterns) > maxPatterns {
+		patterns = patterns[:maxPatterns]
+	}
+
+	var candidates []*corners
+	for i := range patterns {
+		for j := i + 1; j < len(patterns); j++ {
+			for k := j + 1; k < len(patterns); k++ {
+				if c, ok := orient(patterns[i], patterns[j], patterns[k]); ok {
+					candidates = append(candidates, c)
+				}
+			}
+		}
+	}
+	sort.SliceStable(candidates, func(i, j int) bool { return candidates[i].score < candidates[j].score })
+
+	type code struct {
+		text string
+		at   point
+	}
+	var codes []code
+	used := make(map[*pattern]bool)
+	for _, c := range candidates {
+		if used[c.topLeft] || used[c.topRight] || used[c.bottomLeft] {
+			continue
+		}
+		text, err := b.decode(c)
+		if err != nil {
+			continue
+		}
+		codes = append(codes, code{text, c.topLeft.point})
+		used[c.topLeft], used[c.topRight], used[c.bottomLeft] = true, true, true
+	}
+	if len(codes) == 0 {
+		return nil, ErrNotFound
+	}
+	sort.SliceStable(codes, func(i, j int) bool {
+		if math.Abs(codes[i].at.y-codes[j].at.y) > 1 {
+			return codes[i].at.y < codes[j].at.y
+		}
+		return codes[i].at.x < codes[j].at.x
+	})
+	texts := make([]string, len(codes))
+	for i, c := range codes {
+		texts[i] = c.text
+	}
+	return texts, nil
+}
+
+// corners are the three finder patterns of a code.
+type corners struct {
+	topLeft, topRight, bottomLeft *pattern
+	// score is lower the closer the patterns are to the corners of
+	// a square.
+	score float64
+}
+
+// orient checks that three finder patterns may be the corners of a
+// code and tells them apart. The top left pattern is at the right
+// angle and the top right one follows it clockwise.
+func orient(a, b, c *pattern) (*corners, bool) {
+	minModule := math.Min(a.module, math.Min(b.module, c.module))
+	maxModule := math.Max(a.module, math.Max(b.module, c.module))
+	if maxModule > maxSkew*minModule {
+		return nil, false
+	}
+	// The top left pattern is opposite the longest side.
+	ab, ac, bc := distance(a.point, b.point), distance(a.point, c.point), distance(b.point, c.point)
+	switch {
+	case bc >= ab && bc >= ac:
+	case ac >= ab && ac >= bc:
+		a, b = b, a
+	default:
+		a, c = c, a
+	}
+	ab, ac = distance(a.point, b.point), distance(a.point, c.point)
+	if math.Max(ab, ac) > maxSkew*math.Min(ab, ac) {
+		return nil, false
+	}
+	cos := ((b.x-a.x)*(c.x-a.x) + (b.y-a.y)*(c.y-a.y)) / (ab * ac)
+	if math.Abs(cos) > maxCos {
+		return nil, false
+	}
+	module := (a.module + b.module + c.module) / 3
+	// Allow for modules of rotated codes looking larger.
+	if size := (ab+ac)/(2*module) + 7; size < 14 || size > 181 {
+		return nil, false
+	}
+	// Image coordinates grow downwards, so clockwise turns have a
+	// positive cross product.
+	if (b.x-a.x)*(c.y-a.y)-(b.y-a.y)*(c.x-a.x) < 0 {
+		b, c = c, b
+	}
+	score := math.Abs(ab-ac)/math.Max(ab, ac) + math.Abs(cos)
+	return &corners{topLeft: a, topRight: b, bottomLeft: c, score: score}, true
+}
+
+// decode samples and decodes the code with corners c, trying sizes
+// around the one estimated from the distances between them.
+func (b *bitmap) decode(c *corners) (string, error) {
+	tl, tr, bl := c.topLeft.point, c.topRight.point, c.bottomLeft.point
+	// Runs along rows and columns overestimate the modules of
+	// rotated codes, measure them along the sides instead.
+	module := (b.moduleAlong(tl, tr) + b.moduleAlong(tr, tl) + b.moduleAlong(tl, bl) + b.moduleAlong(bl, tl)) / 4
+	if module == 0 {
+		module = (c.topLeft.module + c.topRight.module + c.bottomLeft.module) / 3
+	}
+	size := int(math.Floor((distance(tl, tr)+distance(tl, bl))/(2*module)+0.5)) + 7
+	// Sizes are 4v+17 for versions v.
+	switch size & 3 {
+	case 0:
+		size++
+	case 2:
+		size--
+	case 3:
+		size -= 2
+	}
+	err := ErrNotFound
+	for _, s := range []int{size, size + 4, size - 4} {
+		if s < 21 || s > 177 {
+			continue
+		}
+		var text string
+		if text, err = b.decodeSize(c, module, s); err == nil {
+			return text, nil
+		}
+	}
+	return "", err
+}
+
+// moduleAlong measures the finder pattern centered at p along the
+// line towards q and returns its module size, or zero when the line
+// does not cross a whole pattern.
+func (b *bitmap) moduleAlong(p, q point) float64 {
+	d := distance(p, q)
+	if d == 0 {
+		return 0
+	}
+	dx, dy := (q.x-p.x)/d, (q.y-p.y)/d
+	// From the center to the far edge of the pattern and back the
+	// other way are 3.5 modules each: the black center, the white
+	// ring and the black ring.
+	var total float64
+	for _, dir := range []float64{1, -1} {
+		transitions := 0
+		black := true
+		i := 0.0
+		for ; transitions < 3 && i < d; i++ {
+			x, y := p.x+dir*i*dx, p.y+dir*i*dy
+			if !inside(b, int(x), int(y)) {
+				return 0
+			}
+			if b.at(int(x), int(y)) != black {
+				black = !black
+				transitions++
+			}
+		}
+		if transitions < 3 {
+			return 0
+		}
+		// The last pixel read is past the edge.
+		total += i - 1.5
+	}
+	return total / 7
+}
+
+// decodeSize samples the code with corners c as a code of size
+// modules on a side and decodes it. The fourth corner is placed on
+// the bottom right alignment pattern where there is one, which
+// corrects for perspective, or completes a parallelogram.
+func (b *bitmap) decodeSize(c *corners, module float64, size int) (string, error) {
+	tl, tr, bl := c.topLeft.point, c.topRight.point, c.bottomLeft.point
+	s := float64(size)
+	br := point{tr.x - tl.x + bl.x, tr.y - tl.y + bl.y}
+	src := [4]point{{3.5, 3.5}, {s - 3.5, 3.5}, {3.5, s - 3.5}, {s - 3.5, s - 3.5}}
+	dst := [4]point{tl, tr, bl, br}
+
+	var tries [][4]point
+	if size > 21 {
+		// The alignment pattern is centered three modules in from
+		// where the bottom right finder pattern would be.
+		f := 1 - 3/(s-7)
+		est := point{tl.x + f*(br.x-tl.x), tl.y + f*(br.y-tl.y)}
+		found := b.findAlignments(est, module, alignmentRadius*module)
+		for i := 0; i < len(found) && i < maxAlignments; i++ {
+			aligned := dst
+			aligned[3] = found[i].point
+			tries = append(tries, aligned)
+		}
+	}
+	aligned := len(tries)
+	tries = append(tries, dst)
+
+	err := ErrNotFound
+	for i, d := range tries {
+		from := src
+		if i < aligned {
+			from[3] = point{s - 6.5, s - 6.5}
+		}
+		t, ok := newTransform(from, d)
+		if !ok {
+			continue
+		}
+		var text string
+		if text, err = decodeModules(b.sample(t, size)); err == nil {
+			return text, nil
+		}
+	}
+	return "", err
+}
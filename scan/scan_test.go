@@ -0,0 +1,141 @@
+// Copyright (C) 2014-15 Michail Kargakis
+// This source code is subject to the terms
+// of the MIT License
+
+package scan
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/jpeg"
+	"image/png"
+	"math/rand"
+	"testing"
+
+	"rsc.io/qr"
+)
+
+// texts are the strings printed as QR codes on paper wallets.
+var texts = []string{
+	// WIF
+	"KwDiBf89QgGbjEhKnhXJuH7LrciVrZi3qYjgd9M7rFU73sVHnoWn",
+	// P2PKH address
+	"1BgGZ9tcN4rm9KBzDn7KprQz87SZ26SAMH",
+	// Bech32 address, in byte and alphanumeric mode
+	"bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t4",
+	"BC1QW508D6QEJXTDG4Y5R3ZARVARY0C5XW7KV8F3T4",
+	// BIP38 encrypted key
+	"6PRVWUbkzzsbcVac2qwfssoUJAN1Xhrg6bNk8J7Nzm5H7kxEbn2Nh2ZoGg",
+}
+
+var levels = []qr.Level{qr.L, qr.M, qr.Q, qr.H}
+
+func TestDecode(t *testing.T) {
+	for _, text := range texts {
+		for _, level := range levels {
+			for _, scale := range []int{2, 3, 5, 8} {
+				c, err := qr.Encode(text, level)
+				if err != nil {
+					t.Fatal(err)
+				}
+				c.Scale = scale
+				got, err := Decode(codeImage(t, c))
+				if err != nil {
+					t.Errorf("%s level %d scale %d: %v", text, level, scale, err)
+					continue
+				}
+				if len(got) != 1 || got[0] != text {
+					t.Errorf("%s level %d scale %d: decoded %q", text, level, scale, got)
+				}
+			}
+		}
+	}
+}
+
+func TestDecodeDamaged(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for _, text := range texts {
+		c, err := qr.Encode(text, qr.H)
+		if err != nil {
+			t.Fatal(err)
+		}
+		c.Scale = 6
+		code := codeImage(t, c)
+		img := image.NewGray(code.Bounds())
+		draw.Draw(img, img.Bounds(), code, image.Point{}, draw.Src)
+
+		// Flip a block of data modules below the top timing
+		// pattern, which the error correction must fix.
+		for y := 9; y < 12; y++ {
+			for x := c.Size / 2; x < c.Size/2+3; x++ {
+				invertModule(img, c, x, y)
+			}
+		}
+		// Add noise and recompress as a low quality JPEG.
+		for i := range img.Pix {
+			v := int(img.Pix[i]) + r.Intn(81) - 40
+			if v < 0 {
+				v = 0
+			} else if v > 255 {
+				v = 255
+			}
+			img.Pix[i] = byte(v)
+		}
+		var buf bytes.Buffer
+		if err := jpeg.Encode(&buf, img, &jpeg.Options{Quality: 30}); err != nil {
+			t.Fatal(err)
+		}
+		photo, err := jpeg.Decode(&buf)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		got, err := Decode(photo)
+		if err != nil {
+			t.Errorf("%s: %v", text, err)
+			continue
+		}
+		if len(got) != 1 || got[0] != text {
+			t.Errorf("%s: decoded %q", text, got)
+		}
+	}
+}
+
+// codeImage returns the image of c. The images of qr.Code itself are
+// not scaled and have no quiet zone, unlike its PNGs.
+func codeImage(t *testing.T, c *qr.Code) image.Image {
+	img, err := png.Decode(bytes.NewReader(c.PNG()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return img
+}
+
+// invertModule inverts the module of c at x, y in img, which holds
+// the image of c.
+func invertModule(img *image.Gray, c *qr.Code, x, y int) {
+	// Codes are drawn with a quiet zone of four modules.
+	x0, y0 := (x+4)*c.Scale, (y+4)*c.Scale
+	for py := y0; py < y0+c.Scale; py++ {
+		for px := x0; px < x0+c.Scale; px++ {
+			img.SetGray(px, py, color.Gray{255 - img.GrayAt(px, py).Y})
+		}
+	}
+}
+
+func TestDecodeNotFound(t *testing.T) {
+	blank := image.NewGray(image.Rect(0, 0, 200, 200))
+	draw.Draw(blank, blank.Bounds(), image.White, image.Point{}, draw.Src)
+
+	r := rand.New(rand.NewSource(1))
+	noise := image.NewGray(image.Rect(0, 0, 200, 200))
+	r.Read(noise.Pix)
+
+	for name, img := range map[string]image.Image{"blank": blank, "noise": noise} {
+		if got, err := Decode(img); err != ErrNotFound {
+			t.Errorf("%s: decoded %q, error %v, want %v", name, got, err, ErrNotFound)
+		}
+	}
+}
@@ -0,0 +1,97 @@
+// Copyright (C) 2014-15 Michail Kargakis
+// This source code is subject to the terms
+// of the MIT License
+
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/kargakis/cryptowallet/paper"
+	"github.com/kargakis/cryptowallet/slip39"
+	"github.com/kargakis/cryptowallet/wallet"
+	"golang.org/x/term"
+)
+
+// maxShares is the most shares SLIP-39 splits a secret into.
+const maxShares = 16
+
+// shareKey splits the private key into SLIP-39 shares and prints each
+// on its own page, or on the terminal in dump mode. The private key
+// is the master secret of the shares.
+func shareKey(pk *wallet.PrivKey) {
+	var passphrase string
+	if conf.Passphrase {
+		passphrase = readPassphrase("SLIP-39 passphrase: ", true)
+	}
+	groups, err := slip39.Split(paddedKey(pk.ECPrivKey()), passphrase, 1, []slip39.Group{{Threshold: conf.Threshold, Count: conf.Shares}}, 0)
+	debug(err, "Cannot split private key")
+	var shares []*paper.Share
+	for _, s := range groups[0] {
+		shares = append(shares, &paper.Share{
+			Mnemonic:  s.Mnemonic(),
+			Index:     s.MemberIndex + 1,
+			Threshold: s.MemberThreshold,
+			Count:     conf.Shares,
+		})
+	}
+
+	if conf.DumpString {
+		fmt.Println(pk.Address())
+		for _, s := range shares {
+			fmt.Printf("Share %d of %d: %s\n", s.Index, s.Count, s.Mnemonic)
+		}
+		return
+	}
+	logo, err := paper.NetworkLogo(network)
+	debug(err, "Cannot load logo")
+	f, err := os.OpenFile(walletFile, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+	debug(err, "Cannot create "+walletFile)
+	if err := paper.WriteShares(f, pk.Address(), shares, &paper.Options{Logo: logo}); err != nil {
+		f.Close()
+		os.Remove(walletFile)
+		debug(err, "Cannot generate "+walletFile)
+	}
+	debug(f.Close(), "Cannot generate "+walletFile)
+	fmt.Println("Successfully generated " + walletFile)
+}
+
+// recoverShares combines SLIP-39 shares read from stdin, one per
+// line, and prints the private key they hold along with its address.
+func recoverShares() {
+	var passphrase string
+	if conf.Passphrase {
+		passphrase = readPassphrase("SLIP-39 passphrase: ", false)
+	}
+	if term.IsTerminal(int(os.Stdin.Fd())) {
+		fmt.Println("Enter one share per line and finish with Ctrl-D:")
+	}
+	var mnemonics []string
+	scanner := bufio.NewScanner(os.Stdin)
+	for scanner.Scan() {
+		if line := strings.TrimSpace(scanner.Text()); line != "" {
+			mnemonics = append(mnemonics, line)
+		}
+	}
+	debug(scanner.Err(), "Cannot read shares")
+	secret, err := slip39.Combine(mnemonics, passphrase)
+	debug(err, "Cannot recover private key")
+	if len(secret) != btcec.PrivKeyBytesLen {
+		fmt.Println("Shares do not hold a private key!")
+		os.Exit(1)
+	}
+	ecKey, _ := btcec.PrivKeyFromBytes(btcec.S256(), secret)
+	pk, err := wallet.PrivKeyFromEC(network, keyOptions(), ecKey)
+	debug(err, "Cannot encode private key")
+	fmt.Println(pk)
+	fmt.Println(pk.Address())
+}
+
+func paddedKey(pk *btcec.PrivateKey) []byte {
+	b := pk.D.Bytes()
+	return append(make([]byte, btcec.PrivKeyBytesLen-len(b)), b...)
+}
@@ -0,0 +1,135 @@
+// Copyright (C) 2014-15 Michail Kargakis
+// This source code is subject to the terms
+// of the MIT License
+
+package slip39
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+)
+
+const (
+	// Share indexes reserved for the digest share and the secret.
+	digestIndex = 254
+	secretIndex = 255
+
+	digestLen = 4
+)
+
+// exp and log are the exponent and logarithm tables of GF(256) with
+// the Rijndael polynomial x^8 + x^4 + x^3 + x + 1 and generator 3.
+var exp, log [256]byte
+
+func init() {
+	x := 1
+	for i := 0; i < 255; i++ {
+		exp[i] = byte(x)
+		log[x] = byte(i)
+		// Multiply by the generator, x + 1.
+		x ^= x << 1
+		if x&0x100 != 0 {
+			x ^= 0x11b
+		}
+	}
+}
+
+func mul(a, b byte) byte {
+	if a == 0 || b == 0 {
+		return 0
+	}
+	return exp[(int(log[a])+int(log[b]))%255]
+}
+
+func div(a, b byte) byte {
+	if a == 0 {
+		return 0
+	}
+	return exp[(int(log[a])-int(log[b])+255)%255]
+}
+
+// point is a share of a secret, the evaluation at x of the
+// polynomials hiding each of its bytes.
+type point struct {
+	x     byte
+	value []byte
+}
+
+// interpolate evaluates at x the polynomials of the lowest degree
+// passing through points.
+func interpolate(points []point, x byte) []byte {
+	result := make([]byte, len(points[0].value))
+	for i, p := range points {
+		if p.x == x {
+			return append([]byte{}, p.value...)
+		}
+		// The Lagrange basis polynomial of p at x. Subtraction is
+		// addition, XOR, in GF(256).
+		basis := byte(1)
+		for j, q := range points {
+			if i != j {
+				basis = mul(basis, div(x^q.x, p.x^q.x))
+			}
+		}
+		for k, v := range p.value {
+			result[k] ^= mul(v, basis)
+		}
+	}
+	return result
+}
+
+// splitSecret splits secret into count shares, any threshold of which
+// recover it. The polynomials go through a digest of the secret at
+// digestIndex so that recovery can be checked.
+func splitSecret(threshold, count int, secret []byte) ([]point, error) {
+	if threshold < 1 || threshold > count || count > maxShares {
+		return nil, ErrThreshold
+	}
+	var shares []point
+	if threshold == 1 {
+		for i := 0; i < count; i++ {
+			shares = append(shares, point{byte(i), secret})
+		}
+		return shares, nil
+	}
+
+	for i := 0; i < threshold-2; i++ {
+		value := make([]byte, len(secret))
+		if _, err := rand.Read(value); err != nil {
+			return nil, err
+		}
+		shares = append(shares, point{byte(i), value})
+	}
+	randomPart := make([]byte, len(secret)-digestLen)
+	if _, err := rand.Read(randomPart); err != nil {
+		return nil, err
+	}
+	digestShare := append(digest(randomPart, secret), randomPart...)
+	base := append(append([]point{}, shares...), point{digestIndex, digestShare}, point{secretIndex, secret})
+	for i := threshold - 2; i < count; i++ {
+		shares = append(shares, point{byte(i), interpolate(base, byte(i))})
+	}
+	return shares, nil
+}
+
+// recoverSecret recovers the secret split into shares and checks it
+// against its digest.
+func recoverSecret(threshold int, shares []point) ([]byte, error) {
+	if threshold == 1 {
+		return shares[0].value, nil
+	}
+	secret := interpolate(shares, secretIndex)
+	digestShare := interpolate(shares, digestIndex)
+	if !bytes.Equal(digestShare[:digestLen], digest(digestShare[digestLen:], secret)) {
+		return nil, ErrDigest
+	}
+	return secret, nil
+}
+
+func digest(randomPart, secret []byte) []byte {
+	mac := hmac.New(sha256.New, randomPart)
+	mac.Write(secret)
+	return mac.Sum(nil)[:digestLen]
+}
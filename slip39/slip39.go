@@ -0,0 +1,408 @@
+// Copyright (C) 2014-15 Michail Kargakis
+// This source code is subject to the terms
+// of the MIT License
+
+// Package slip39 implements Shamir's secret sharing of a master
+// secret over GF(256) with the mnemonic shares of SLIP-39.
+package slip39
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"strings"
+
+	"golang.org/x/crypto/pbkdf2"
+)
+
+var (
+	// ErrSecretSize is returned for master secrets that are shorter
+	// than 128 bits or of an odd number of bytes.
+	ErrSecretSize = errors.New("slip39: master secret must be at least 128 bits and an even number of bytes")
+
+	// ErrThreshold is returned for thresholds that are out of range.
+	ErrThreshold = errors.New("slip39: invalid threshold or share count")
+
+	// ErrMnemonic is returned for malformed share mnemonics.
+	ErrMnemonic = errors.New("slip39: invalid share mnemonic")
+
+	// ErrChecksum is returned when the checksum of a share does not
+	// match.
+	ErrChecksum = errors.New("slip39: invalid share checksum")
+
+	// ErrMismatch is returned for shares of different secrets or with
+	// inconsistent parameters.
+	ErrMismatch = errors.New("slip39: shares do not belong together")
+
+	// ErrNotEnoughShares is returned when fewer shares than the
+	// thresholds call for are combined.
+	ErrNotEnoughShares = errors.New("slip39: not enough shares")
+
+	// ErrDigest is returned when the recovered secret does not match
+	// its digest.
+	ErrDigest = errors.New("slip39: invalid digest of the shared secret")
+)
+
+const (
+	radix     = 1024
+	radixBits = 10
+
+	// Words holding the identifier, the extendable flag, the
+	// iteration exponent and the group and member parameters.
+	idExpWords     = 2
+	metadataWords  = idExpWords + 2 + checksumWords
+	checksumWords  = 3
+	minMnemonicLen = metadataWords + (128+radixBits-1)/radixBits
+
+	baseIterations = 10000
+	rounds         = 4
+	maxShares      = 16
+)
+
+var (
+	wordIndex = make(map[string]int, radix)
+	words     []string
+)
+
+func init() {
+	words = strings.Fields(wordList)
+	for i, w := range words {
+		wordIndex[w] = i
+	}
+}
+
+// Group holds the member threshold and count of a group of shares.
+type Group struct {
+	Threshold int
+	Count     int
+}
+
+// Share is a share of a master secret.
+type Share struct {
+	Identifier        uint16
+	Extendable        bool
+	IterationExponent int
+	GroupIndex        int
+	GroupThreshold    int
+	GroupCount        int
+	MemberIndex       int
+	MemberThreshold   int
+	Value             []byte
+}
+
+// Split encrypts secret with passphrase and splits it into groups of
+// shares. Any groupThreshold groups with at least the threshold of
+// shares of each group recover the secret. Each iteration exponent
+// doubles the work of the encryption.
+func Split(secret []byte, passphrase string, groupThreshold int, groups []Group, iterationExponent int) ([][]*Share, error) {
+	if len(secret) < 16 || len(secret)%2 != 0 {
+		return nil, ErrSecretSize
+	}
+	if groupThreshold < 1 || groupThreshold > len(groups) || iterationExponent < 0 || iterationExponent > 15 {
+		return nil, ErrThreshold
+	}
+	for _, g := range groups {
+		if g.Threshold == 1 && g.Count > 1 {
+			// A single share would recover the group, so more
+			// are pointless.
+			return nil, ErrThreshold
+		}
+	}
+	var id [2]byte
+	if _, err := rand.Read(id[:]); err != nil {
+		return nil, err
+	}
+	identifier := binary.BigEndian.Uint16(id[:]) & 0x7fff
+
+	encrypted := encrypt(secret, passphrase, iterationExponent, identifier, false)
+	groupShares, err := splitSecret(groupThreshold, len(groups), encrypted)
+	if err != nil {
+		return nil, err
+	}
+	result := make([][]*Share, len(groups))
+	for i, g := range groups {
+		members, err := splitSecret(g.Threshold, g.Count, groupShares[i].value)
+		if err != nil {
+			return nil, err
+		}
+		for _, m := range members {
+			result[i] = append(result[i], &Share{
+				Identifier:        identifier,
+				IterationExponent: iterationExponent,
+				GroupIndex:        i,
+				GroupThreshold:    groupThreshold,
+				GroupCount:        len(groups),
+				MemberIndex:       int(m.x),
+				MemberThreshold:   g.Threshold,
+				Value:             m.value,
+			})
+		}
+	}
+	return result, nil
+}
+
+// Combine recovers the master secret from share mnemonics and
+// decrypts it with passphrase.
+func Combine(mnemonics []string, passphrase string) ([]byte, error) {
+	if len(mnemonics) == 0 {
+		return nil, ErrNotEnoughShares
+	}
+	var shares []*Share
+	for _, m := range mnemonics {
+		s, err := ParseShare(m)
+		if err != nil {
+			return nil, err
+		}
+		shares = append(shares, s)
+	}
+
+	first := shares[0]
+	groups := make(map[int][]point)
+	memberThresholds := make(map[int]int)
+	for _, s := range shares {
+		if s.Identifier != first.Identifier || s.Extendable != first.Extendable ||
+			s.IterationExponent != first.IterationExponent || s.GroupThreshold != first.GroupThreshold ||
+			s.GroupCount != first.GroupCount || len(s.Value) != len(first.Value) {
+			return nil, ErrMismatch
+		}
+		if t, ok := memberThresholds[s.GroupIndex]; ok && t != s.MemberThreshold {
+			return nil, ErrMismatch
+		}
+		memberThresholds[s.GroupIndex] = s.MemberThreshold
+		for _, p := range groups[s.GroupIndex] {
+			if p.x == byte(s.MemberIndex) {
+				return nil, ErrMismatch
+			}
+		}
+		groups[s.GroupIndex] = append(groups[s.GroupIndex], point{byte(s.MemberIndex), s.Value})
+	}
+
+	var groupShares []point
+	for index, members := range groups {
+		if len(members) < memberThresholds[index] {
+			continue
+		}
+		secret, err := recoverSecret(memberThresholds[index], members[:memberThresholds[index]])
+		if err != nil {
+			return nil, err
+		}
+		groupShares = append(groupShares, point{byte(index), secret})
+	}
+	if len(groupShares) < first.GroupThreshold {
+		return nil, ErrNotEnoughShares
+	}
+	encrypted, err := recoverSecret(first.GroupThreshold, groupShares[:first.GroupThreshold])
+	if err != nil {
+		return nil, err
+	}
+	return decrypt(encrypted, passphrase, first.IterationExponent, first.Identifier, first.Extendable), nil
+}
+
+// Mnemonic returns the share as a mnemonic sentence.
+func (s *Share) Mnemonic() string {
+	ext := 0
+	if s.Extendable {
+		ext = 1
+	}
+	idExp := int(s.Identifier)<<5 | ext<<4 | s.IterationExponent
+	params := s.GroupIndex<<16 | (s.GroupThreshold-1)<<12 | (s.GroupCount-1)<<8 | s.MemberIndex<<4 | (s.MemberThreshold - 1)
+	data := append(toIndices(idExp, idExpWords), toIndices(params, 2)...)
+	data = append(data, valueIndices(s.Value)...)
+	data = append(data, checksum(customization(s.Extendable), data)...)
+	parts := make([]string, len(data))
+	for i, d := range data {
+		parts[i] = words[d]
+	}
+	return strings.Join(parts, " ")
+}
+
+// ParseShare decodes a share mnemonic and verifies its checksum.
+func ParseShare(mnemonic string) (*Share, error) {
+	fields := strings.Fields(strings.ToLower(mnemonic))
+	if len(fields) < minMnemonicLen {
+		return nil, ErrMnemonic
+	}
+	data := make([]int, len(fields))
+	for i, f := range fields {
+		index, ok := wordIndex[f]
+		if !ok {
+			return nil, ErrMnemonic
+		}
+		data[i] = index
+	}
+	// Padding of the value to whole words is under 16 bits.
+	paddingLen := radixBits * (len(data) - metadataWords) % 16
+	if paddingLen > 8 {
+		return nil, ErrMnemonic
+	}
+
+	idExp := fromIndices(data[:idExpWords])
+	s := &Share{
+		Identifier:        uint16(idExp >> 5),
+		Extendable:        idExp>>4&1 == 1,
+		IterationExponent: idExp & 0xf,
+	}
+	if polymod(customization(s.Extendable), data) != 1 {
+		return nil, ErrChecksum
+	}
+	params := fromIndices(data[idExpWords : idExpWords+2])
+	s.GroupIndex = params >> 16
+	s.GroupThreshold = params>>12&0xf + 1
+	s.GroupCount = params>>8&0xf + 1
+	s.MemberIndex = params >> 4 & 0xf
+	s.MemberThreshold = params&0xf + 1
+	if s.GroupThreshold > s.GroupCount {
+		return nil, ErrMnemonic
+	}
+
+	valueData := data[idExpWords+2 : len(data)-checksumWords]
+	value, ok := indicesValue(valueData, (radixBits*len(valueData)-paddingLen)/8)
+	if !ok {
+		return nil, ErrMnemonic
+	}
+	s.Value = value
+	return s, nil
+}
+
+// encrypt encrypts secret with a four round Feistel network whose
+// round function is PBKDF2 of the passphrase.
+func encrypt(secret []byte, passphrase string, e int, identifier uint16, extendable bool) []byte {
+	half := len(secret) / 2
+	l, r := secret[:half], secret[half:]
+	salt := salt(identifier, extendable)
+	for i := 0; i < rounds; i++ {
+		l, r = r, xor(l, roundFunction(i, passphrase, e, salt, r))
+	}
+	return append(append([]byte{}, r...), l...)
+}
+
+// decrypt reverses encrypt.
+func decrypt(encrypted []byte, passphrase string, e int, identifier uint16, extendable bool) []byte {
+	half := len(encrypted) / 2
+	l, r := encrypted[:half], encrypted[half:]
+	salt := salt(identifier, extendable)
+	for i := rounds - 1; i >= 0; i-- {
+		l, r = r, xor(l, roundFunction(i, passphrase, e, salt, r))
+	}
+	return append(append([]byte{}, r...), l...)
+}
+
+func roundFunction(i int, passphrase string, e int, salt, r []byte) []byte {
+	password := append([]byte{byte(i)}, passphrase...)
+	return pbkdf2.Key(password, append(append([]byte{}, salt...), r...), (baseIterations<<uint(e))/rounds, len(r), sha256.New)
+}
+
+// salt binds the encryption of shares that are not extendable to
+// their identifier.
+func salt(identifier uint16, extendable bool) []byte {
+	if extendable {
+		return nil
+	}
+	return []byte{'s', 'h', 'a', 'm', 'i', 'r', byte(identifier >> 8), byte(identifier)}
+}
+
+func customization(extendable bool) string {
+	if extendable {
+		return "shamir_extendable"
+	}
+	return "shamir"
+}
+
+func xor(a, b []byte) []byte {
+	out := make([]byte, len(a))
+	for i := range a {
+		out[i] = a[i] ^ b[i]
+	}
+	return out
+}
+
+// toIndices splits the lowest n*10 bits of v into n word indices.
+func toIndices(v, n int) []int {
+	indices := make([]int, n)
+	for i := n - 1; i >= 0; i-- {
+		indices[i] = v % radix
+		v /= radix
+	}
+	return indices
+}
+
+func fromIndices(indices []int) int {
+	v := 0
+	for _, i := range indices {
+		v = v*radix + i
+	}
+	return v
+}
+
+// valueIndices encodes value in 10-bit words, padded with leading
+// zero bits.
+func valueIndices(value []byte) []int {
+	n := (8*len(value) + radixBits - 1) / radixBits
+	indices := make([]int, n)
+	// Leading padding bits count towards the first word.
+	acc, bits := 0, n*radixBits-8*len(value)
+	i := 0
+	for _, b := range value {
+		acc = acc<<8 | int(b)
+		bits += 8
+		for bits >= radixBits {
+			bits -= radixBits
+			indices[i] = acc >> uint(bits) & (radix - 1)
+			i++
+		}
+	}
+	return indices
+}
+
+// indicesValue decodes size bytes from 10-bit words, checking that the
+// leading padding bits are zero.
+func indicesValue(indices []int, size int) ([]byte, bool) {
+	padding := radixBits*len(indices) - 8*size
+	if padding < 0 || padding >= radixBits || indices[0]>>uint(radixBits-padding) != 0 {
+		return nil, false
+	}
+	value := make([]byte, 0, size)
+	acc, bits := 0, -padding
+	for _, index := range indices {
+		acc = acc<<radixBits | index
+		bits += radixBits
+		for bits >= 8 {
+			bits -= 8
+			value = append(value, byte(acc>>uint(bits)))
+		}
+	}
+	return value, true
+}
+
+var generator = [10]int{
+	0xe0e040, 0x1c1c080, 0x3838100, 0x7070200, 0xe0e0009,
+	0x1c0c2412, 0x38086c24, 0x3090fc48, 0x21b1f890, 0x3f3f120,
+}
+
+// polymod computes the RS1024 checksum polynomial of values after the
+// customization string.
+func polymod(customization string, values []int) int {
+	chk := 1
+	step := func(v int) {
+		b := chk >> 20
+		chk = (chk&0xfffff)<<10 ^ v
+		for i := 0; i < 10; i++ {
+			if b>>uint(i)&1 == 1 {
+				chk ^= generator[i]
+			}
+		}
+	}
+	for _, c := range []byte(customization) {
+		step(int(c))
+	}
+	for _, v := range values {
+		step(v)
+	}
+	return chk
+}
+
+func checksum(customization string, data []int) []int {
+	values := append(append([]int{}, data...), 0, 0, 0)
+	return toIndices(polymod(customization, values)^1, checksumWords)
+}
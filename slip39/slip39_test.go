@@ -0,0 +1,250 @@
+// Copyright (C) 2014-15 Michail Kargakis
+// This source code is subject to the terms
+// of the MIT License
+
+package slip39
+
+import (
+	"bytes"
+	"encoding/hex"
+	"strings"
+	"testing"
+)
+
+// vectors are test vectors of SLIP-39 with the passphrase TREZOR.
+// Invalid mnemonics have no secret.
+var vectors = []struct {
+	description string
+	mnemonics   []string
+	secret      string
+	err         error
+}{
+	{
+		"1. Valid mnemonic without sharing (128 bits)",
+		[]string{"duckling enlarge academic academic agency result length solution fridge kidney coal piece deal husband erode duke ajar critical decision keyboard"},
+		"bb54aac4b89dc868ba37d9cc21b2cece", nil,
+	},
+	{
+		"2. Mnemonic with invalid checksum (128 bits)",
+		[]string{"duckling enlarge academic academic agency result length solution fridge kidney coal piece deal husband erode duke ajar critical decision kidney"},
+		"", ErrChecksum,
+	},
+	{
+		"3. Mnemonic with invalid padding (128 bits)",
+		[]string{"duckling enlarge academic academic email result length solution fridge kidney coal piece deal husband erode duke ajar music cargo fitness"},
+		"", ErrMnemonic,
+	},
+	{
+		"4. Basic sharing 2-of-3 (128 bits)",
+		[]string{
+			"shadow pistol academic always adequate wildlife fancy gross oasis cylinder mustang wrist rescue view short owner flip making coding armed",
+			"shadow pistol academic acid actress prayer class unknown daughter sweater depict flip twice unkind craft early superior advocate guest smoking",
+		},
+		"b43ceb7e57a0ea8766221624d01b0864", nil,
+	},
+	{
+		"5. Basic sharing 2-of-3 (128 bits)",
+		[]string{"shadow pistol academic always adequate wildlife fancy gross oasis cylinder mustang wrist rescue view short owner flip making coding armed"},
+		"", ErrNotEnoughShares,
+	},
+	{
+		"6. Mnemonics with different identifiers (128 bits)",
+		[]string{
+			"adequate smoking academic acid debut wine petition glen cluster slow rhyme slow simple epidemic rumor junk tracks treat olympic tolerate",
+			"adequate stay academic agency agency formal party ting frequent learn upstairs remember smear leaf damage anatomy ladle market hush corner",
+		},
+		"", ErrMismatch,
+	},
+	{
+		"7. Mnemonics with different iteration exponents (128 bits)",
+		[]string{
+			"peasant leaves academic acid desert exact olympic math alive axle trial tackle drug deny decent smear dominant desert bucket remind",
+			"peasant leader academic agency cultural blessing percent network envelope medal junk primary human pumps jacket fragment payroll ticket evoke voice",
+		},
+		"", ErrMismatch,
+	},
+	{
+		"8. Mnemonics with mismatching group thresholds (128 bits)",
+		[]string{
+			"liberty category beard echo animal fawn temple briefing math username various wolf aviation fancy visual holy thunder yelp helpful payment",
+			"liberty category beard email beyond should fancy romp founder easel pink holy hairy romp loyalty material victim owner toxic custody",
+			"liberty category academic easy being hazard crush diminish oral lizard reaction cluster force dilemma deploy force club veteran expect photo",
+		},
+		"", ErrMismatch,
+	},
+	{
+		"17. Threshold number of groups and members in each group (128 bits, case 1)",
+		[]string{
+			"eraser senior beard romp adorn nuclear spill corner cradle style ancient family general leader ambition exchange unusual garlic promise voice",
+			"eraser senior ceramic snake clay various huge numb argue hesitate auction category timber browser greatest hanger petition script leaf pickup",
+			"eraser senior ceramic shaft dynamic become junior wrist silver peasant force math alto coal amazing segment yelp velvet image paces",
+			"eraser senior ceramic round column hawk trust auction smug shame alive greatest sheriff living perfect corner chest sled fumes adequate",
+			"eraser senior decision smug corner ruin rescue cubic angel tackle skin skunk program roster trash rumor slush angel flea amazing",
+		},
+		"7c3397a292a5941682d7a4ae2d898d11", nil,
+	},
+	{
+		"21. Valid mnemonic without sharing (256 bits)",
+		[]string{"theory painting academic academic armed sweater year military elder discuss acne wildlife boring employer fused large satoshi bundle carbon diagnose anatomy hamster leaves tracks paces beyond phantom capital marvel lips brave detect luck"},
+		"989baf9dcaad5b10ca33dfd8cc75e42477025dce88ae83e75a230086a0e00e92", nil,
+	},
+	{
+		"24. Basic sharing 2-of-3 (256 bits)",
+		[]string{
+			"humidity disease academic always aluminum jewelry energy woman receiver strategy amuse duckling lying evidence network walnut tactics forget hairy rebound impulse brother survive clothes stadium mailman rival ocean reward venture always armed unwrap",
+			"humidity disease academic agency actress jacket gross physics cylinder solution fake mortgage benefit public busy prepare sharp friar change work slow purchase ruler again tricycle involve viral wireless mixture anatomy desert cargo upgrade",
+		},
+		"c938b319067687e990e05e0da0ecce1278f75ff58d9853f19dcaeed5de104aae", nil,
+	},
+	{
+		"41. Valid extendable mnemonic without sharing (128 bits)",
+		[]string{"testify swimming academic academic column loyalty smear include exotic bedroom exotic wrist lobe cover grief golden smart junior estimate learn"},
+		"1679b4516e0ee5954351d288a838f45e", nil,
+	},
+	{
+		"42. Extendable basic sharing 2-of-3 (128 bits)",
+		[]string{
+			"enemy favorite academic acid cowboy phrase havoc level response walnut budget painting inside trash adjust froth kitchen learn tidy punish",
+			"enemy favorite academic always academic sniff script carpet romp kind promise scatter center unfair training emphasis evening belong fake enforce",
+		},
+		"48b1a4b80b8c209ad42c33672bdaa428", nil,
+	},
+}
+
+func TestVectors(t *testing.T) {
+	for _, v := range vectors {
+		secret, err := Combine(v.mnemonics, "TREZOR")
+		if err != v.err {
+			t.Errorf("%s: error %v, want %v", v.description, err, v.err)
+			continue
+		}
+		if got := hex.EncodeToString(secret); got != v.secret {
+			t.Errorf("%s: secret %s, want %s", v.description, got, v.secret)
+		}
+		if err != nil {
+			continue
+		}
+		for _, m := range v.mnemonics {
+			share, err := ParseShare(m)
+			if err != nil {
+				t.Fatalf("%s: %v", v.description, err)
+			}
+			if got := share.Mnemonic(); got != m {
+				t.Errorf("%s: mnemonic %s, want %s", v.description, got, m)
+			}
+		}
+	}
+}
+
+func TestSplit(t *testing.T) {
+	secret := []byte("ABCDEFGHIJKLMNOPQRSTUVWXYZ012345")
+	tests := []struct {
+		groupThreshold int
+		groups         []Group
+		// pick holds the indices of the members of each group to
+		// combine.
+		pick [][]int
+		err  error
+	}{
+		{1, []Group{{1, 1}}, [][]int{{0}}, nil},
+		{1, []Group{{2, 3}}, [][]int{{2, 0}}, nil},
+		{1, []Group{{3, 5}}, [][]int{{1, 2}}, ErrNotEnoughShares},
+		{2, []Group{{1, 1}, {2, 3}, {3, 5}}, [][]int{{0}, nil, {4, 1, 3}}, nil},
+		{2, []Group{{1, 1}, {2, 3}, {3, 5}}, [][]int{nil, {1, 2}, {0, 2}}, ErrNotEnoughShares},
+		{3, []Group{{2, 2}, {2, 3}, {1, 1}, {4, 16}}, [][]int{{0, 1}, {2, 1}, nil, {15, 3, 7, 9}}, nil},
+	}
+	for i, test := range tests {
+		groups, err := Split(secret, "TREZOR", test.groupThreshold, test.groups, 0)
+		if err != nil {
+			t.Fatalf("%d: %v", i, err)
+		}
+		var mnemonics []string
+		for g, members := range test.pick {
+			for _, m := range members {
+				mnemonics = append(mnemonics, groups[g][m].Mnemonic())
+			}
+		}
+		recovered, err := Combine(mnemonics, "TREZOR")
+		if err != test.err {
+			t.Errorf("%d: error %v, want %v", i, err, test.err)
+			continue
+		}
+		if err == nil && !bytes.Equal(recovered, secret) {
+			t.Errorf("%d: recovered %x, want %x", i, recovered, secret)
+		}
+	}
+}
+
+func TestSplitInvalid(t *testing.T) {
+	tests := []struct {
+		secret         []byte
+		groupThreshold int
+		groups         []Group
+		err            error
+	}{
+		{make([]byte, 15), 1, []Group{{1, 1}}, ErrSecretSize},
+		{make([]byte, 17), 1, []Group{{1, 1}}, ErrSecretSize},
+		{make([]byte, 16), 2, []Group{{1, 1}}, ErrThreshold},
+		{make([]byte, 16), 0, []Group{{1, 1}}, ErrThreshold},
+		{make([]byte, 16), 1, []Group{{3, 2}}, ErrThreshold},
+		{make([]byte, 16), 1, []Group{{1, 2}}, ErrThreshold},
+		{make([]byte, 16), 1, []Group{{2, 17}}, ErrThreshold},
+	}
+	for i, test := range tests {
+		if _, err := Split(test.secret, "", test.groupThreshold, test.groups, 0); err != test.err {
+			t.Errorf("%d: error %v, want %v", i, err, test.err)
+		}
+	}
+}
+
+func TestCombineInvalid(t *testing.T) {
+	secret := make([]byte, 16)
+	split := func() []string {
+		groups, err := Split(secret, "", 1, []Group{{2, 3}}, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return []string{groups[0][0].Mnemonic(), groups[0][1].Mnemonic()}
+	}
+	a, b := split(), split()
+
+	// A wrong passphrase decrypts to another secret.
+	recovered, err := Combine(a, "TREZOR")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bytes.Equal(recovered, secret) {
+		t.Errorf("wrong passphrase: recovered the secret")
+	}
+
+	// The shares of two splits have different identifiers.
+	if _, err := Combine([]string{a[0], b[1]}, ""); err != ErrMismatch {
+		t.Errorf("different identifiers: error %v, want %v", err, ErrMismatch)
+	}
+	// The same share twice is one share.
+	if _, err := Combine([]string{a[0], a[0]}, ""); err != ErrMismatch {
+		t.Errorf("repeated share: error %v, want %v", err, ErrMismatch)
+	}
+	if _, err := Combine(a[:1], ""); err != ErrNotEnoughShares {
+		t.Errorf("one share: error %v, want %v", err, ErrNotEnoughShares)
+	}
+	if _, err := Combine(nil, ""); err != ErrNotEnoughShares {
+		t.Errorf("no shares: error %v, want %v", err, ErrNotEnoughShares)
+	}
+
+	words := strings.Fields(a[0])
+	swapped := append([]string{}, words...)
+	swapped[5], swapped[6] = swapped[6], swapped[5]
+	if words[5] != words[6] {
+		if _, err := ParseShare(strings.Join(swapped, " ")); err != ErrChecksum {
+			t.Errorf("swapped words: error %v, want %v", err, ErrChecksum)
+		}
+	}
+	if _, err := ParseShare(strings.Join(words[:len(words)-1], " ")); err == nil {
+		t.Errorf("missing word: parsed")
+	}
+	words[3] = "bitcoin"
+	if _, err := ParseShare(strings.Join(words, " ")); err != ErrMnemonic {
+		t.Errorf("unknown word: error %v, want %v", err, ErrMnemonic)
+	}
+}
@@ -0,0 +1,87 @@
+// Copyright (C) 2014-15 Michail Kargakis
+// This source code is subject to the terms
+// of the MIT License
+
+// Package taproot implements the key tweak of BIP341 for taproot
+// outputs without a script tree, as used by BIP86.
+package taproot
+
+import (
+	"crypto/sha256"
+	"errors"
+	"math/big"
+
+	"github.com/btcsuite/btcd/btcec"
+)
+
+// ErrInvalidTweak is returned for the negligible chance of a tweak
+// that is out of range or yields the point at infinity.
+var ErrInvalidTweak = errors.New("taproot: invalid key tweak")
+
+// TaggedHash returns the BIP340 tagged hash of msgs under tag.
+func TaggedHash(tag string, msgs ...[]byte) []byte {
+	tagHash := sha256.Sum256([]byte(tag))
+	h := sha256.New()
+	h.Write(tagHash[:])
+	h.Write(tagHash[:])
+	for _, m := range msgs {
+		h.Write(m)
+	}
+	return h.Sum(nil)
+}
+
+// XOnly returns the 32-byte x-only serialization of pub.
+func XOnly(pub *btcec.PublicKey) []byte {
+	return pub.SerializeCompressed()[1:]
+}
+
+// OutputKey returns the x-only output key committing to the internal
+// key pub and no script tree. It is the witness program of a P2TR
+// output spendable with the key tweaked by TweakPrivKey.
+func OutputKey(pub *btcec.PublicKey) ([]byte, error) {
+	curve := btcec.S256()
+	// Lift the internal key to the point with an even y coordinate.
+	y := new(big.Int).Set(pub.Y)
+	if y.Bit(0) == 1 {
+		y.Sub(curve.P, y)
+	}
+	t, err := tweak(pub)
+	if err != nil {
+		return nil, err
+	}
+	tx, ty := curve.ScalarBaseMult(t)
+	qx, qy := curve.Add(pub.X, y, tx, ty)
+	if qx.Sign() == 0 && qy.Sign() == 0 {
+		return nil, ErrInvalidTweak
+	}
+	return XOnly(&btcec.PublicKey{Curve: curve, X: qx, Y: qy}), nil
+}
+
+// TweakPrivKey returns the private key of the output key of pk.
+func TweakPrivKey(pk *btcec.PrivateKey) (*btcec.PrivateKey, error) {
+	curve := btcec.S256()
+	d := new(big.Int).Set(pk.D)
+	if pk.PubKey().Y.Bit(0) == 1 {
+		d.Sub(curve.N, d)
+	}
+	t, err := tweak(pk.PubKey())
+	if err != nil {
+		return nil, err
+	}
+	d.Add(d, new(big.Int).SetBytes(t))
+	d.Mod(d, curve.N)
+	if d.Sign() == 0 {
+		return nil, ErrInvalidTweak
+	}
+	b := d.Bytes()
+	tweaked, _ := btcec.PrivKeyFromBytes(curve, append(make([]byte, 32-len(b)), b...))
+	return tweaked, nil
+}
+
+func tweak(pub *btcec.PublicKey) ([]byte, error) {
+	t := TaggedHash("TapTweak", XOnly(pub))
+	if new(big.Int).SetBytes(t).Cmp(btcec.S256().N) >= 0 {
+		return nil, ErrInvalidTweak
+	}
+	return t, nil
+}
@@ -0,0 +1,68 @@
+// Copyright (C) 2014-15 Michail Kargakis
+// This source code is subject to the terms
+// of the MIT License
+
+package taproot
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/kargakis/cryptowallet/bech32"
+	"github.com/kargakis/cryptowallet/bip32"
+	"github.com/kargakis/cryptowallet/bip39"
+)
+
+// vectors are the BIP86 test vectors of account 0.
+var vectors = []struct {
+	path, internal, output, address string
+}{
+	{"m/86'/0'/0'/0/0", "cc8a4bc64d897bddc5fbc2f670f7a8ba0b386779106cf1223c6fc5d7cd6fc115", "a60869f0dbcf1dc659c9cecbaf8050135ea9e8cdc487053f1dc6880949dc684c", "bc1p5cyxnuxmeuwuvkwfem96lqzszd02n6xdcjrs20cac6yqjjwudpxqkedrcr"},
+	{"m/86'/0'/0'/0/1", "83dfe85a3151d2517290da461fe2815591ef69f2b18a2ce63f01697a8b313145", "a82f29944d65b86ae6b5e5cc75e294ead6c59391a1edc5e016e3498c67fc7bbb", "bc1p4qhjn9zdvkux4e44uhx8tc55attvtyu358kutcqkudyccelu0was9fqzwh"},
+	{"m/86'/0'/0'/1/0", "399f1b2f4393f29a18c937859c5dd8a77350103157eb880f02e8c08214277cef", "882d74e5d0572d5a816cef0041a96b6c1de832f6f9676d9605c44d5e9a97d3dc", "bc1p3qkhfews2uk44qtvauqyr2ttdsw7svhkl9nkm9s9c3x4ax5h60wqwruhk7"},
+}
+
+func TestVectors(t *testing.T) {
+	seed := bip39.NewSeed("abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about", "")
+	master, err := bip32.NewMaster(seed, bip32.Versions{
+		Private: [4]byte{0x04, 0x88, 0xad, 0xe4},
+		Public:  [4]byte{0x04, 0x88, 0xb2, 0x1e},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, v := range vectors {
+		key, err := master.Derive(v.path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		pk, err := key.ECPrivKey()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := hex.EncodeToString(XOnly(pk.PubKey())); got != v.internal {
+			t.Errorf("%s: internal key %s, want %s", v.path, got, v.internal)
+		}
+		output, err := OutputKey(pk.PubKey())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := hex.EncodeToString(output); got != v.output {
+			t.Errorf("%s: output key %s, want %s", v.path, got, v.output)
+		}
+		tweaked, err := TweakPrivKey(pk)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := hex.EncodeToString(XOnly(tweaked.PubKey())); got != v.output {
+			t.Errorf("%s: tweaked key %s, want %s", v.path, got, v.output)
+		}
+		address, err := bech32.EncodeSegWitAddress("bc", 1, output)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if address != v.address {
+			t.Errorf("%s: address %s, want %s", v.path, address, v.address)
+		}
+	}
+}
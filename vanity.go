@@ -0,0 +1,153 @@
+// Copyright (C) 2014-15 Michail Kargakis
+// This source code is subject to the terms
+// of the MIT License
+
+package main
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"math"
+	"os"
+	"os/signal"
+	"runtime"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcutil"
+	"github.com/kargakis/cryptowallet/vanity"
+	"github.com/kargakis/cryptowallet/wallet"
+)
+
+// progressInterval is how often a vanity search reports progress.
+const progressInterval = 5 * time.Second
+
+// vanityPrivKey searches for a private key whose address starts with
+// the vanity prefix.
+func vanityPrivKey(opts *wallet.Options) *wallet.PrivKey {
+	pk := search(opts, nil)
+	privKey, err := wallet.PrivKeyFromEC(network, opts, pk)
+	debug(err, "Cannot encode private key")
+	return privKey
+}
+
+// newSplitKey prints a new secret key to keep and its public key to
+// hand out for a split-key vanity search.
+func newSplitKey() {
+	pk, err := wallet.NewECPrivKey(rand.Reader)
+	debug(err, "Cannot generate new private key")
+	wif, err := btcutil.NewWIF(pk, network.Params, true)
+	debug(err, "Cannot encode private key to WIF")
+	fmt.Println("Secret key:", wif)
+	fmt.Println("Public key:", hex.EncodeToString(pk.PubKey().SerializeCompressed()))
+}
+
+// searchPartialKey searches for a partial key whose public key
+// combined with the public key of a split-key search has an address
+// starting with the vanity prefix.
+func searchPartialKey(opts *wallet.Options) {
+	b, err := hex.DecodeString(conf.VanityPubKey)
+	debug(err, "Cannot decode public key")
+	base, err := btcec.ParsePubKey(b, btcec.S256())
+	debug(err, "Cannot parse public key")
+	partial := search(opts, base)
+	pub, err := vanity.CombinePubKeys(base, partial.PubKey())
+	debug(err, "Cannot combine public keys")
+	addr, err := wallet.EncodeAddress(pub, !opts.Uncompressed, network, opts.AddressType)
+	debug(err, "Cannot extract public address from public key")
+	wif, err := btcutil.NewWIF(partial, network.Params, true)
+	debug(err, "Cannot encode partial key to WIF")
+	fmt.Println("Partial key:", wif)
+	fmt.Println("Address:", addr)
+}
+
+// combineSplitKey adds a secret key read from the terminal to a
+// partial key found by a split-key search and prints the private key
+// if its address is the expected one.
+func combineSplitKey(opts *wallet.Options) {
+	partial, err := btcutil.DecodeWIF(conf.Combine)
+	debug(err, "Cannot decode partial key")
+	secret, err := btcutil.DecodeWIF(readPassphrase("Secret key: ", false))
+	debug(err, "Cannot decode secret key")
+	pk, err := vanity.CombinePrivKeys(secret.PrivKey, partial.PrivKey)
+	debug(err, "Cannot combine keys")
+	privKey, err := wallet.PrivKeyFromEC(network, opts, pk)
+	debug(err, "Cannot encode private key")
+	if addr := privKey.Address().String(); addr != conf.Expect && privKey.Address().CashAddr() != conf.Expect {
+		fmt.Println("Combined key has address " + addr + " instead of " + conf.Expect + "!")
+		os.Exit(1)
+	}
+	fmt.Println(privKey)
+	fmt.Println(privKey.Address())
+}
+
+// search runs a vanity search on one worker per CPU by default,
+// reporting progress on stderr, and aborts when interrupted.
+func search(opts *wallet.Options, base *btcec.PublicKey) *btcec.PrivateKey {
+	workers := conf.Workers
+	if workers < 1 {
+		workers = runtime.NumCPU()
+	}
+	s := &vanity.Searcher{
+		Network:     network,
+		AddressType: opts.AddressType,
+		Compressed:  !opts.Uncompressed,
+		Prefix:      conf.Vanity,
+		Workers:     workers,
+		Base:        base,
+	}
+	difficulty, err := vanity.Difficulty(network, s.AddressType, s.Prefix)
+	debug(err, "Cannot search for prefix "+s.Prefix)
+	fmt.Fprintf(os.Stderr, "Searching for prefix %s with %d workers, difficulty %.0f\n", s.Prefix, workers, difficulty)
+
+	stop := make(chan struct{})
+	interrupt := make(chan os.Signal, 1)
+	signal.Notify(interrupt, os.Interrupt)
+	defer signal.Stop(interrupt)
+	go func() {
+		if _, ok := <-interrupt; ok {
+			close(stop)
+		}
+	}()
+
+	type result struct {
+		pk  *btcec.PrivateKey
+		err error
+	}
+	done := make(chan result, 1)
+	start := time.Now()
+	go func() {
+		pk, err := s.Run(stop)
+		done <- result{pk, err}
+	}()
+
+	ticker := time.NewTicker(progressInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case r := <-done:
+			if r.err == vanity.ErrStopped {
+				fmt.Printf("Vanity search interrupted after %d attempts\n", s.Attempts())
+				os.Exit(1)
+			}
+			debug(r.err, "Cannot search for prefix "+s.Prefix)
+			fmt.Fprintf(os.Stderr, "Found after %d attempts in %s\n", s.Attempts(), time.Since(start))
+			return r.pk
+		case <-ticker.C:
+			attempts := s.Attempts()
+			rate := float64(attempts) / time.Since(start).Seconds()
+			fmt.Fprintf(os.Stderr, "%d attempts, %.0f/s, 50%% chance in %s\n", attempts, rate, remaining(attempts, difficulty, rate))
+		}
+	}
+}
+
+// remaining estimates the time left until a search with the given
+// difficulty has had an even chance to succeed.
+func remaining(attempts uint64, difficulty, rate float64) time.Duration {
+	half := math.Ln2 * difficulty
+	if rate == 0 || float64(attempts) >= half {
+		return 0
+	}
+	return time.Duration((half - float64(attempts)) / rate * float64(time.Second))
+}
@@ -0,0 +1,150 @@
+// Copyright (C) 2014-15 Michail Kargakis
+// This source code is subject to the terms
+// of the MIT License
+
+package vanity
+
+import (
+	"math"
+	"math/big"
+	"strings"
+
+	"github.com/kargakis/cryptowallet/wallet"
+)
+
+const (
+	base58Alphabet = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+	bech32Charset  = "qpzry9x8gf2tvdw0s3jn54khce6mua7l"
+
+	// Base58 addresses encode a version prefix, a 20-byte hash and
+	// a 4-byte checksum.
+	hashBits = 8 * (20 + 4)
+)
+
+// Difficulty returns the expected number of keys to generate until
+// an address of type t on net starts with prefix. It returns
+// ErrInvalidPrefix for prefixes with characters addresses cannot
+// hold and ErrImpossible for prefixes no address can start with.
+func Difficulty(net *wallet.Network, t wallet.AddressType, prefix string) (float64, error) {
+	if err := wallet.CheckAddressType(net, t, true); err != nil && err != wallet.ErrUncompressedSegwit {
+		return 0, err
+	}
+	switch t {
+	case wallet.P2WPKH:
+		return bech32Difficulty(prefix, net.HRP, 0, 160)
+	case wallet.P2TR:
+		return bech32Difficulty(prefix, net.HRP, 1, 256)
+	case wallet.P2SHP2WPKH:
+		return base58Difficulty(prefix, net.ScriptHashID)
+	case wallet.EVM:
+		// EVM addresses are not searched for.
+		return 0, wallet.ErrUnsupportedAddress
+	default:
+		return base58Difficulty(prefix, net.PubKeyHashID)
+	}
+}
+
+// base58Difficulty counts the payloads with the given version prefix
+// whose base58 encoding starts with prefix.
+func base58Difficulty(prefix string, version []byte) (float64, error) {
+	for _, c := range prefix {
+		if !strings.ContainsRune(base58Alphabet, c) {
+			return 0, ErrInvalidPrefix
+		}
+	}
+	// Leading zero bytes are encoded as ones, the rest of the
+	// payload as a base58 number without leading zeros.
+	ones := len(prefix) - len(strings.TrimLeft(prefix, "1"))
+	rest := prefix[ones:]
+	payloadLen := len(version) + hashBits/8
+	if ones > payloadLen {
+		return 0, ErrImpossible
+	}
+
+	lo := new(big.Int).Lsh(new(big.Int).SetBytes(version), hashBits)
+	hi := new(big.Int).Add(lo, new(big.Int).Lsh(big.NewInt(1), hashBits))
+	// Payloads with exactly as many leading zero bytes as the prefix
+	// has ones, or at least as many if nothing follows them.
+	zeroHi := new(big.Int).Lsh(big.NewInt(1), uint(8*(payloadLen-ones)))
+	hi = minInt(hi, zeroHi)
+	if rest != "" && ones < payloadLen {
+		zeroLo := new(big.Int).Lsh(big.NewInt(1), uint(8*(payloadLen-ones-1)))
+		lo = maxInt(lo, zeroLo)
+	}
+
+	matched := new(big.Int)
+	if rest == "" {
+		matched = overlap(lo, hi, lo, hi)
+	} else {
+		value := new(big.Int)
+		for _, c := range rest {
+			value.Mul(value, big.NewInt(58))
+			value.Add(value, big.NewInt(int64(strings.IndexRune(base58Alphabet, c))))
+		}
+		// Numbers of each length starting with the digits of rest.
+		scale := big.NewInt(1)
+		for scale.Cmp(hi) < 0 {
+			start := new(big.Int).Mul(value, scale)
+			end := new(big.Int).Add(start, scale)
+			matched.Add(matched, overlap(start, end, lo, hi))
+			scale.Mul(scale, big.NewInt(58))
+		}
+	}
+	if matched.Sign() == 0 {
+		return 0, ErrImpossible
+	}
+	total := new(big.Int).Lsh(big.NewInt(1), hashBits)
+	difficulty, _ := new(big.Rat).SetFrac(total, matched).Float64()
+	return difficulty, nil
+}
+
+// bech32Difficulty returns the difficulty of prefix for witness
+// programs of the given version and size in bits.
+func bech32Difficulty(prefix, hrp string, version, programBits int) (float64, error) {
+	prefix = strings.ToLower(prefix)
+	fixed := hrp + "1" + string(bech32Charset[version])
+	if len(prefix) <= len(fixed) {
+		if !strings.HasPrefix(fixed, prefix) {
+			return 0, ErrImpossible
+		}
+		return 1, nil
+	}
+	if !strings.HasPrefix(prefix, fixed) {
+		return 0, ErrImpossible
+	}
+	rest := prefix[len(fixed):]
+	for _, c := range rest {
+		if !strings.ContainsRune(bech32Charset, c) {
+			return 0, ErrInvalidPrefix
+		}
+	}
+	// Only characters holding five bits of the program count, the
+	// last one may be padded.
+	if len(rest) > programBits/5 {
+		return 0, ErrImpossible
+	}
+	return math.Pow(32, float64(len(rest))), nil
+}
+
+// overlap returns the size of the intersection of [a, b) and [c, d).
+func overlap(a, b, c, d *big.Int) *big.Int {
+	size := new(big.Int).Sub(minInt(b, d), maxInt(a, c))
+	if size.Sign() < 0 {
+		return new(big.Int)
+	}
+	return size
+}
+
+func minInt(a, b *big.Int) *big.Int {
+	if a.Cmp(b) < 0 {
+		return a
+	}
+	return b
+}
+
+func maxInt(a, b *big.Int) *big.Int {
+	if a.Cmp(b) > 0 {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,86 @@
+// Copyright (C) 2014-15 Michail Kargakis
+// This source code is subject to the terms
+// of the MIT License
+
+package vanity
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/kargakis/cryptowallet/wallet"
+)
+
+func TestDifficulty(t *testing.T) {
+	btc, err := wallet.NewNetwork("btc", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		addressType wallet.AddressType
+		prefix      string
+		difficulty  float64
+		err         error
+	}{
+		{wallet.P2PKH, "", 1, nil},
+		{wallet.P2PKH, "1", 1, nil},
+		// A second leading zero byte of the hash.
+		{wallet.P2PKH, "11", 256, nil},
+		{wallet.P2PKH, "111", 65536, nil},
+		{wallet.P2SHP2WPKH, "3", 1, nil},
+		{wallet.P2WPKH, "bc1q", 1, nil},
+		{wallet.P2WPKH, "BC1", 1, nil},
+		{wallet.P2WPKH, "bc1qa", 32, nil},
+		{wallet.P2WPKH, "bc1qxy", 1024, nil},
+		{wallet.P2TR, "bc1p", 1, nil},
+		{wallet.P2TR, "bc1pa", 32, nil},
+		// Invalid characters.
+		{wallet.P2PKH, "1O", 0, ErrInvalidPrefix},
+		{wallet.P2PKH, "1l", 0, ErrInvalidPrefix},
+		{wallet.P2PKH, "10", 0, ErrInvalidPrefix},
+		{wallet.P2WPKH, "bc1qb", 0, ErrInvalidPrefix},
+		{wallet.P2WPKH, "bc1q1", 0, ErrInvalidPrefix},
+		// Impossible prefixes.
+		{wallet.P2PKH, "2", 0, ErrImpossible},
+		{wallet.P2PKH, "3", 0, ErrImpossible},
+		{wallet.P2SHP2WPKH, "1", 0, ErrImpossible},
+		{wallet.P2PKH, strings.Repeat("1", 26), 0, ErrImpossible},
+		{wallet.P2WPKH, "tb1q", 0, ErrImpossible},
+		{wallet.P2WPKH, "bc1p", 0, ErrImpossible},
+		{wallet.P2TR, "bc1q", 0, ErrImpossible},
+		{wallet.P2WPKH, "bc1q" + strings.Repeat("a", 33), 0, ErrImpossible},
+	}
+	for _, test := range tests {
+		difficulty, err := Difficulty(btc, test.addressType, test.prefix)
+		if err != test.err {
+			t.Errorf("%s %q: error %v, want %v", test.addressType, test.prefix, err, test.err)
+			continue
+		}
+		if difficulty != test.difficulty {
+			t.Errorf("%s %q: difficulty %v, want %v", test.addressType, test.prefix, difficulty, test.difficulty)
+		}
+	}
+}
+
+func TestDifficultyGrowth(t *testing.T) {
+	btc, err := wallet.NewNetwork("btc", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Every base58 character after the first makes the search about
+	// 58 times longer.
+	prev, err := Difficulty(btc, wallet.P2PKH, "1K")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, prefix := range []string{"1Ki", "1Kid", "1Kidd"} {
+		difficulty, err := Difficulty(btc, wallet.P2PKH, prefix)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if ratio := difficulty / prev; ratio < 50 || ratio > 66 {
+			t.Errorf("%s: difficulty %v is %.1f times that of the shorter prefix", prefix, difficulty, ratio)
+		}
+		prev = difficulty
+	}
+}
@@ -0,0 +1,42 @@
+// Copyright (C) 2014-15 Michail Kargakis
+// This source code is subject to the terms
+// of the MIT License
+
+package vanity
+
+import (
+	"errors"
+	"math/big"
+
+	"github.com/btcsuite/btcd/btcec"
+)
+
+// ErrInfinity is returned for the negligible chance of two keys
+// adding up to the point at infinity or a zero private key.
+var ErrInfinity = errors.New("vanity: keys add up to infinity")
+
+// CombinePubKeys returns the public key of the sum of the private keys
+// of base and partial.
+func CombinePubKeys(base, partial *btcec.PublicKey) (*btcec.PublicKey, error) {
+	curve := btcec.S256()
+	x, y := curve.Add(base.X, base.Y, partial.X, partial.Y)
+	if x.Sign() == 0 && y.Sign() == 0 {
+		return nil, ErrInfinity
+	}
+	return &btcec.PublicKey{Curve: curve, X: x, Y: y}, nil
+}
+
+// CombinePrivKeys returns the sum of secret and partial modulo the
+// curve order, the private key of the public key CombinePubKeys
+// returns for their public keys.
+func CombinePrivKeys(secret, partial *btcec.PrivateKey) (*btcec.PrivateKey, error) {
+	curve := btcec.S256()
+	d := new(big.Int).Add(secret.D, partial.D)
+	d.Mod(d, curve.N)
+	if d.Sign() == 0 {
+		return nil, ErrInfinity
+	}
+	b := d.Bytes()
+	pk, _ := btcec.PrivKeyFromBytes(curve, append(make([]byte, 32-len(b)), b...))
+	return pk, nil
+}
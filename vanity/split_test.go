@@ -0,0 +1,103 @@
+// Copyright (C) 2014-15 Michail Kargakis
+// This source code is subject to the terms
+// of the MIT License
+
+package vanity
+
+import (
+	"crypto/rand"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/kargakis/cryptowallet/wallet"
+)
+
+// address returns the compressed P2PKH address of pub on net.
+func address(t *testing.T, net *wallet.Network, pub *btcec.PublicKey) string {
+	addr, err := wallet.EncodeAddress(pub, true, net, wallet.P2PKH)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return addr
+}
+
+func newKey(t *testing.T) *btcec.PrivateKey {
+	pk, err := wallet.NewECPrivKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return pk
+}
+
+func TestCombine(t *testing.T) {
+	btc, err := wallet.NewNetwork("btc", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 10; i++ {
+		secret, partial := newKey(t), newKey(t)
+		pub, err := CombinePubKeys(secret.PubKey(), partial.PubKey())
+		if err != nil {
+			t.Fatal(err)
+		}
+		pk, err := CombinePrivKeys(secret, partial)
+		if err != nil {
+			t.Fatal(err)
+		}
+		expect := address(t, btc, pub)
+		if got := address(t, btc, pk.PubKey()); got != expect {
+			t.Errorf("combined private key has address %s, want %s", got, expect)
+		}
+
+		// A partial key found for another base gives another
+		// address, which the expected address check rejects.
+		other, err := CombinePrivKeys(secret, newKey(t))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := address(t, btc, other.PubKey()); got == expect {
+			t.Errorf("mismatched partial key has the expected address %s", got)
+		}
+	}
+}
+
+func TestCombineInfinity(t *testing.T) {
+	secret := newKey(t)
+	b := new(big.Int).Sub(btcec.S256().N, secret.D).Bytes()
+	negated, _ := btcec.PrivKeyFromBytes(btcec.S256(), append(make([]byte, 32-len(b)), b...))
+	if _, err := CombinePubKeys(secret.PubKey(), negated.PubKey()); err != ErrInfinity {
+		t.Errorf("public keys: error %v, want %v", err, ErrInfinity)
+	}
+	if _, err := CombinePrivKeys(secret, negated); err != ErrInfinity {
+		t.Errorf("private keys: error %v, want %v", err, ErrInfinity)
+	}
+}
+
+func TestSplitKeySearch(t *testing.T) {
+	btc, err := wallet.NewNetwork("btc", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	secret := newKey(t)
+	s := &Searcher{
+		Network:     btc,
+		AddressType: wallet.P2PKH,
+		Compressed:  true,
+		Prefix:      "1A",
+		Workers:     2,
+		Base:        secret.PubKey(),
+	}
+	partial, err := s.Run(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pk, err := CombinePrivKeys(secret, partial)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if addr := address(t, btc, pk.PubKey()); !strings.HasPrefix(addr, s.Prefix) {
+		t.Errorf("combined key has address %s, want prefix %s", addr, s.Prefix)
+	}
+}
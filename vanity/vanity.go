@@ -0,0 +1,136 @@
+// Copyright (C) 2014-15 Michail Kargakis
+// This source code is subject to the terms
+// of the MIT License
+
+// Package vanity searches for keys whose address starts with a
+// chosen prefix.
+//
+// A split-key search lets a third party search for a prefix without
+// learning the private key. The owner keeps a secret key and hands
+// out its public key, the base. The searcher looks for a partial key
+// whose public key added to the base has an address with the prefix.
+// Only the owner can then combine the secret and partial keys into
+// the private key of that address.
+package vanity
+
+import (
+	"crypto/rand"
+	"errors"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/kargakis/cryptowallet/wallet"
+)
+
+var (
+	// ErrInvalidPrefix is returned for prefixes with characters that
+	// addresses of the requested type cannot hold.
+	ErrInvalidPrefix = errors.New("vanity: prefix has characters invalid in addresses")
+
+	// ErrImpossible is returned for prefixes no address of the
+	// requested type can start with.
+	ErrImpossible = errors.New("vanity: no address can start with prefix")
+
+	// ErrStopped is returned when a search is stopped before a match
+	// is found.
+	ErrStopped = errors.New("vanity: search stopped")
+)
+
+// Searcher searches for a private key whose address starts with
+// Prefix. When Base is set, it searches for a partial key whose
+// public key combined with Base has such an address instead. Its
+// attempts can be read while it runs.
+type Searcher struct {
+	// attempts is accessed atomically and kept first for 64-bit
+	// alignment.
+	attempts uint64
+
+	Network     *wallet.Network
+	AddressType wallet.AddressType
+	Compressed  bool
+	Prefix      string
+	// Workers is the number of goroutines generating keys.
+	Workers int
+	// Base is the public key of the secret of a split-key search.
+	Base *btcec.PublicKey
+}
+
+// Attempts returns the number of keys generated so far.
+func (s *Searcher) Attempts() uint64 { return atomic.LoadUint64(&s.attempts) }
+
+// Run generates keys until one matches or stop is closed, in which
+// case ErrStopped is returned.
+func (s *Searcher) Run(stop <-chan struct{}) (*btcec.PrivateKey, error) {
+	if _, err := Difficulty(s.Network, s.AddressType, s.Prefix); err != nil {
+		return nil, err
+	}
+	prefix := s.Prefix
+	if s.AddressType != wallet.P2PKH && s.AddressType != wallet.P2SHP2WPKH {
+		// Bech32 addresses are lowercase.
+		prefix = strings.ToLower(prefix)
+	}
+	workers := s.Workers
+	if workers < 1 {
+		workers = 1
+	}
+
+	done := make(chan struct{})
+	found := make(chan *btcec.PrivateKey, workers)
+	errc := make(chan error, workers)
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			pk, err := s.search(prefix, done)
+			if err != nil {
+				errc <- err
+			} else if pk != nil {
+				found <- pk
+			}
+		}()
+	}
+
+	var pk *btcec.PrivateKey
+	var err error
+	select {
+	case pk = <-found:
+	case err = <-errc:
+	case <-stop:
+		err = ErrStopped
+	}
+	close(done)
+	wg.Wait()
+	return pk, err
+}
+
+// search generates keys until one matches prefix or done is closed.
+func (s *Searcher) search(prefix string, done <-chan struct{}) (*btcec.PrivateKey, error) {
+	for {
+		select {
+		case <-done:
+			return nil, nil
+		default:
+		}
+		pk, err := wallet.NewECPrivKey(rand.Reader)
+		if err != nil {
+			return nil, err
+		}
+		atomic.AddUint64(&s.attempts, 1)
+		pub := pk.PubKey()
+		if s.Base != nil {
+			if pub, err = CombinePubKeys(s.Base, pub); err == ErrInfinity {
+				continue
+			}
+		}
+		addr, err := wallet.EncodeAddress(pub, s.Compressed, s.Network, s.AddressType)
+		if err != nil {
+			return nil, err
+		}
+		if strings.HasPrefix(addr, prefix) {
+			return pk, nil
+		}
+	}
+}
@@ -0,0 +1,78 @@
+// Copyright (C) 2014-15 Michail Kargakis
+// This source code is subject to the terms
+// of the MIT License
+
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/kargakis/cryptowallet/scan"
+	"github.com/kargakis/cryptowallet/wallet"
+	"golang.org/x/term"
+)
+
+// verifyPrivKeys reads private keys in WIF from the terminal without
+// echoing them, or one per line when piped, and describes each.
+func verifyPrivKeys() {
+	if term.IsTerminal(int(os.Stdin.Fd())) {
+		fmt.Print("Private key: ")
+		wif, err := term.ReadPassword(int(os.Stdin.Fd()))
+		fmt.Println()
+		debug(err, "Cannot read private key")
+		verifyPrivKey(string(wif))
+		return
+	}
+	scanner := bufio.NewScanner(os.Stdin)
+	for scanner.Scan() {
+		if line := strings.TrimSpace(scanner.Text()); line != "" {
+			verifyPrivKey(line)
+		}
+	}
+	debug(scanner.Err(), "Cannot read private keys")
+}
+
+// scanImage decodes the QR codes of an image, such as a photo or
+// scan of a paper wallet, and prints their text so it can be checked
+// against the text printed next to them. Private keys in WIF are
+// verified as well.
+func scanImage(name string) {
+	texts, err := scan.DecodeFile(name)
+	debug(err, "Cannot decode QR codes of "+name)
+	for i, text := range texts {
+		fmt.Printf("QR code %d: %s\n", i+1, text)
+		if _, _, err := wallet.DecodeWIF(text); err == nil {
+			verifyPrivKey(text)
+		}
+	}
+}
+
+// verifyPrivKey checks the checksum and network of a private key in
+// WIF and prints, for each network it may belong to, its address of
+// every type the coin supports.
+func verifyPrivKey(s string) {
+	wif, nets, err := wallet.DecodeWIF(s)
+	debug(err, "Invalid private key")
+	fmt.Println("Compressed:", wif.CompressPubKey)
+	for _, net := range nets {
+		name := "mainnet"
+		if net.Testnet {
+			name = "testnet"
+		}
+		fmt.Printf("%s %s\n", strings.ToUpper(net.Coin), name)
+		for _, t := range wallet.AddressTypes() {
+			if wallet.CheckAddressType(net, t, wif.CompressPubKey) != nil {
+				continue
+			}
+			addr, err := wallet.NewAddress(wif, net, t)
+			debug(err, "Cannot extract public address from private key")
+			fmt.Printf("  %-12s %s\n", t+":", addr)
+			if addr.CashAddr() != "" {
+				fmt.Printf("  %-12s %s\n", "cashaddr:", addr.CashAddr())
+			}
+		}
+	}
+}
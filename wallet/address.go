@@ -0,0 +1,174 @@
+// Copyright (C) 2014-15 Michail Kargakis
+// This source code is subject to the terms
+// of the MIT License
+
+package wallet
+
+import (
+	"errors"
+	"fmt"
+	"image"
+	"strings"
+
+	"github.com/btcsuite/btcutil"
+	"github.com/btcsuite/btcutil/base58"
+	"github.com/kargakis/cryptowallet/bech32"
+
+	"rsc.io/qr"
+)
+
+// AddressType is the type of address paying to a key.
+type AddressType string
+
+// Supported address types.
+const (
+	P2PKH      AddressType = "p2pkh"
+	P2WPKH     AddressType = "p2wpkh"
+	P2SHP2WPKH AddressType = "p2sh-p2wpkh"
+	P2TR       AddressType = "p2tr"
+
+	// EVM is the only address type of EVM chains such as Ethereum.
+	EVM AddressType = "evm"
+
+	// Multisig address types.
+	P2SH      AddressType = "p2sh"
+	P2WSH     AddressType = "p2wsh"
+	P2SHP2WSH AddressType = "p2sh-p2wsh"
+)
+
+var (
+	// ErrUnsupportedAddress is returned for unknown address types.
+	ErrUnsupportedAddress = errors.New("wallet: unsupported address type")
+
+	// ErrNoSegwit is returned for segwit addresses of coins without
+	// segwit.
+	ErrNoSegwit = errors.New("wallet: coin does not support segwit addresses")
+
+	// ErrUncompressedSegwit is returned for segwit addresses of
+	// uncompressed public keys.
+	ErrUncompressedSegwit = errors.New("wallet: segwit addresses require compressed public keys")
+
+	// ErrNoTaproot is returned for taproot addresses of coins without
+	// taproot.
+	ErrNoTaproot = errors.New("wallet: coin does not support taproot addresses")
+)
+
+// AddressTypes returns the address types paying to a single key.
+func AddressTypes() []AddressType {
+	return []AddressType{P2PKH, P2WPKH, P2SHP2WPKH, P2TR}
+}
+
+// bip44Purpose maps address types to the purpose of their default
+// derivation path: BIP44, BIP84, BIP49 and BIP86 respectively, and
+// BIP44 for EVM addresses as in MetaMask.
+var bip44Purpose = map[AddressType]int{
+	P2PKH:      44,
+	P2WPKH:     84,
+	P2SHP2WPKH: 49,
+	P2TR:       86,
+	EVM:        44,
+}
+
+// CheckAddressType returns an error when net has no addresses of
+// type t for public keys in the requested format.
+func CheckAddressType(net *Network, t AddressType, compressed bool) error {
+	if net.EVM != (t == EVM) {
+		return ErrUnsupportedAddress
+	}
+	switch t {
+	case P2PKH, EVM:
+	case P2WPKH, P2SHP2WPKH, P2TR:
+		if net.HRP == "" {
+			return ErrNoSegwit
+		}
+		if t == P2TR && !net.Taproot {
+			return ErrNoTaproot
+		}
+		if !compressed {
+			return ErrUncompressedSegwit
+		}
+	default:
+		return ErrUnsupportedAddress
+	}
+	return nil
+}
+
+// DefaultPath returns the BIP32 derivation path of the first
+// receiving key of the first account for addresses of type t.
+func DefaultPath(net *Network, t AddressType) string {
+	return fmt.Sprintf("m/%d'/%d'/0'/0/0", bip44Purpose[t], net.Params.HDCoinType)
+}
+
+// address is a cryptocoin address that can be encoded for display.
+// It is satisfied by base58Address, witnessAddress and evmAddress.
+type address interface {
+	EncodeAddress() string
+	ScriptAddress() []byte
+}
+
+// witnessAddress is a segregated witness address encoded in bech32.
+type witnessAddress struct {
+	encoded string
+	program []byte
+}
+
+func newWitnessAddress(hrp string, version byte, program []byte) (*witnessAddress, error) {
+	encoded, err := bech32.EncodeSegWitAddress(hrp, version, program)
+	if err != nil {
+		return nil, err
+	}
+	return &witnessAddress{encoded: encoded, program: program}, nil
+}
+
+func (a *witnessAddress) EncodeAddress() string { return a.encoded }
+func (a *witnessAddress) ScriptAddress() []byte { return a.program }
+func (a *witnessAddress) String() string        { return a.encoded }
+
+// base58Address is a pay-to-pubkey-hash or pay-to-script-hash
+// address encoded in base58 with a checksum. Unlike btcutil
+// addresses, its version prefix may be more than one byte.
+type base58Address struct {
+	encoded string
+	hash    []byte
+}
+
+func newBase58Address(version, hash []byte) *base58Address {
+	payload := append(append([]byte{}, version[1:]...), hash...)
+	return &base58Address{encoded: base58.CheckEncode(payload, version[0]), hash: hash}
+}
+
+func (a *base58Address) EncodeAddress() string { return a.encoded }
+func (a *base58Address) ScriptAddress() []byte { return a.hash }
+func (a *base58Address) String() string        { return a.encoded }
+
+// witnessPubKeyHashScript returns the version 0 witness program
+// paying to the hash of pubKey. Nested in P2SH it is the redeem
+// script of a P2SH-P2WPKH address.
+func witnessPubKeyHashScript(pubKey []byte) []byte {
+	// OP_0 <20-byte hash>
+	return append([]byte{0x00, 0x14}, btcutil.Hash160(pubKey)...)
+}
+
+// qrText returns the text to encode in the QR code of addr. Bech32
+// addresses are case insensitive so they are uppercased to fit the
+// more compact alphanumeric QR mode.
+func qrText(addr address) string {
+	if _, ok := addr.(*witnessAddress); ok {
+		return strings.ToUpper(addr.EncodeAddress())
+	}
+	return addr.EncodeAddress()
+}
+
+// QRImage returns code drawn one pixel per module, bounded by the
+// code itself rather than its quiet zone.
+func QRImage(code *qr.Code) image.Image {
+	return qrImage{code.Image(), code.Size}
+}
+
+// qrImage bounds the image of a QR code to its modules.
+type qrImage struct {
+	image.Image
+	size int
+}
+
+func (i qrImage) Bounds() image.Rectangle { return image.Rect(0, 0, i.size, i.size) }
@@ -0,0 +1,76 @@
+// Copyright (C) 2014-15 Michail Kargakis
+// This source code is subject to the terms
+// of the MIT License
+
+package wallet
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec"
+)
+
+func TestCheckAddressType(t *testing.T) {
+	tests := []struct {
+		coin       string
+		t          AddressType
+		compressed bool
+		err        error
+	}{
+		{"btc", P2PKH, false, nil},
+		{"btc", P2WPKH, true, nil},
+		{"btc", P2TR, true, nil},
+		{"btc", P2TR, false, ErrUncompressedSegwit},
+		{"btc", EVM, true, ErrUnsupportedAddress},
+		{"ltc", P2TR, true, nil},
+		{"dgb", P2WPKH, true, nil},
+		{"dgb", P2TR, true, ErrNoTaproot},
+		{"btg", P2TR, true, ErrNoTaproot},
+		{"doge", P2WPKH, true, ErrNoSegwit},
+		{"doge", P2TR, true, ErrNoSegwit},
+		{"eth", EVM, true, nil},
+		{"eth", P2TR, true, ErrUnsupportedAddress},
+	}
+	for _, test := range tests {
+		net, err := NewNetwork(test.coin, false)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := CheckAddressType(net, test.t, test.compressed); err != test.err {
+			t.Errorf("%s %s: %v, want %v", test.coin, test.t, err, test.err)
+		}
+	}
+}
+
+func TestNestedSegWit(t *testing.T) {
+	pk, _ := btcec.PrivKeyFromBytes(btcec.S256(), key1)
+	// The redeem script is the witness program of the hash of the
+	// compressed public key.
+	redeemScript := "0014751e76e8199196d454941c45d1b3a323f1433bd6"
+	tests := []struct {
+		coin    string
+		testnet bool
+		address string
+	}{
+		{"btc", false, "3JvL6Ymt8MVWiCNHC7oWU6nLeHNJKLZGLN"},
+		{"btc", true, "2NAUYAHhujozruyzpsFRP63mbrdaU5wnEpN"},
+		{"ltc", false, "MR8UQSBr5ULwWheBHznrHk2jxyxkHQu8vB"},
+	}
+	for _, test := range tests {
+		net, err := NewNetwork(test.coin, test.testnet)
+		if err != nil {
+			t.Fatal(err)
+		}
+		privKey, err := PrivKeyFromEC(net, &Options{AddressType: P2SHP2WPKH}, pk)
+		if err != nil {
+			t.Fatal(err)
+		}
+		addr := privKey.Address()
+		if got := addr.String(); got != test.address {
+			t.Errorf("%s testnet %v: address %s, want %s", test.coin, test.testnet, got, test.address)
+		}
+		if got := addr.RedeemScript(); got != redeemScript {
+			t.Errorf("%s testnet %v: redeem script %s, want %s", test.coin, test.testnet, got, redeemScript)
+		}
+	}
+}
@@ -0,0 +1,321 @@
+// Copyright (C) 2014-15 Michail Kargakis
+// This source code is subject to the terms
+// of the MIT License
+
+package wallet
+
+import (
+	"bytes"
+	_ "embed"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/kargakis/cryptowallet/bip32"
+	"gopkg.in/yaml.v2"
+)
+
+var (
+	// ErrUnsupportedCoin is returned for coins missing from the registry.
+	ErrUnsupportedCoin = errors.New("wallet: unsupported coin")
+
+	// ErrInvalidCoin is returned for registry entries with missing or
+	// malformed parameters.
+	ErrInvalidCoin = errors.New("wallet: invalid coin parameters")
+)
+
+// Coin holds the parameters of a coin on its main and test networks.
+type Coin struct {
+	// Name is the display name of the coin.
+	Name string `yaml:"name"`
+	// Logo is the file name of the PNG logo printed on paper wallets,
+	// relative to the registry file it is read from. The logo built
+	// into the paper package is used when empty.
+	Logo string `yaml:"logo"`
+	// EVM marks account-based chains running the Ethereum virtual
+	// machine. They share addresses and keys, differing only in
+	// their names and chain IDs, and need no address prefixes.
+	EVM bool `yaml:"evm"`
+	// Taproot marks coins that activated taproot, whose segwit
+	// addresses include P2TR outputs.
+	Taproot bool           `yaml:"taproot"`
+	Mainnet *NetworkParams `yaml:"mainnet"`
+	Testnet *NetworkParams `yaml:"testnet"`
+}
+
+// NetworkParams are the parameters of a coin on one of its networks.
+type NetworkParams struct {
+	// Version bytes of pay-to-pubkey-hash and pay-to-script-hash
+	// addresses and of private keys in WIF. WIF is a pointer so a
+	// missing version byte is told apart from 0.
+	PubKeyHash Prefix `yaml:"pubkeyhash"`
+	ScriptHash Prefix `yaml:"scripthash"`
+	WIF        *byte  `yaml:"wif"`
+	// HRP is the human-readable part of segwit addresses, empty for
+	// coins without segwit.
+	HRP string `yaml:"hrp"`
+	// CashAddr is the prefix of CashAddr addresses, empty for coins
+	// without them.
+	CashAddr string `yaml:"cashaddr"`
+	// XPrv and XPub are the hex BIP32 extended key version bytes.
+	XPrv string `yaml:"xprv"`
+	XPub string `yaml:"xpub"`
+	// CoinType is the SLIP-44 coin type of BIP44 derivation paths.
+	CoinType uint32 `yaml:"cointype"`
+	// ChainID is the EIP-155 chain ID of EVM chains.
+	ChainID uint64 `yaml:"chainid"`
+}
+
+// Prefix is the version prefix of base58 addresses. Most coins have a
+// single version byte, written as a number in registries, while some
+// have several, written as a list.
+type Prefix []byte
+
+// UnmarshalYAML decodes a prefix from a number or a list of numbers.
+func (p *Prefix) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var b byte
+	if err := unmarshal(&b); err == nil {
+		*p = Prefix{b}
+		return nil
+	}
+	var bytes []byte
+	if err := unmarshal(&bytes); err != nil {
+		return err
+	}
+	*p = bytes
+	return nil
+}
+
+// defaultCoins is the registry of the coins supported out of the box.
+//
+//go:embed coins.json
+var defaultCoins []byte
+
+// coins is the registry of supported coins by lowercase ticker.
+var coins = map[string]*Coin{}
+
+func init() {
+	if err := LoadCoins(bytes.NewReader(defaultCoins)); err != nil {
+		panic(err)
+	}
+}
+
+// LoadCoins adds the coins of a YAML or JSON registry read from r to
+// the supported coins, replacing those with the same ticker. The
+// registry maps tickers to coins:
+//
+//	ltc:
+//	  name: Litecoin
+//	  taproot: true
+//	  mainnet: {pubkeyhash: 48, scripthash: 50, wif: 176, hrp: ltc, xprv: 0488ade4, xpub: 0488b21e, cointype: 2}
+//	  testnet: {pubkeyhash: 111, scripthash: 58, wif: 239, hrp: tltc, xprv: 04358394, xpub: 043587cf, cointype: 1}
+//	pol:
+//	  name: Polygon
+//	  evm: true
+//	  mainnet: {chainid: 137, xprv: 0488ade4, xpub: 0488b21e, cointype: 60}
+//	  testnet: {chainid: 80002, xprv: 0488ade4, xpub: 0488b21e, cointype: 60}
+//
+// No coin is added when any of them is invalid. Logo file names are
+// kept as they are.
+func LoadCoins(r io.Reader) error {
+	registry, err := readCoins(r, "")
+	if err != nil {
+		return err
+	}
+	addCoins(registry)
+	return nil
+}
+
+// LoadCoinsFile adds the coins of the registry in the named file as
+// LoadCoins does, resolving relative logo file names against the
+// directory of the file.
+func LoadCoinsFile(name string) error {
+	f, err := os.Open(name)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	registry, err := readCoins(f, filepath.Dir(name))
+	if err != nil {
+		return err
+	}
+	addCoins(registry)
+	return nil
+}
+
+// readCoins reads and checks the registry in r. Relative logo file
+// names are joined to dir unless it is empty.
+func readCoins(r io.Reader, dir string) (map[string]*Coin, error) {
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	registry := map[string]*Coin{}
+	if err := yaml.UnmarshalStrict(data, &registry); err != nil {
+		return nil, err
+	}
+	for ticker, coin := range registry {
+		if ticker == "" {
+			return nil, ErrInvalidCoin
+		}
+		if err := coin.check(); err != nil {
+			return nil, fmt.Errorf("%v: %s", err, ticker)
+		}
+		if dir != "" && coin.Logo != "" && !filepath.IsAbs(coin.Logo) {
+			coin.Logo = filepath.Join(dir, coin.Logo)
+		}
+	}
+	return registry, nil
+}
+
+// addCoins adds the coins of registry to the supported coins by
+// lowercase ticker.
+func addCoins(registry map[string]*Coin) {
+	for ticker, coin := range registry {
+		coins[strings.ToLower(ticker)] = coin
+	}
+}
+
+// check returns ErrInvalidCoin unless coin has a name and valid
+// parameters on both networks: address and WIF prefixes or, for EVM
+// chains, chain IDs, and BIP32 versions. Taproot coins need segwit HRPs.
+func (coin *Coin) check() error {
+	if coin == nil || coin.Name == "" || coin.Mainnet == nil || coin.Testnet == nil {
+		return ErrInvalidCoin
+	}
+	for _, params := range []*NetworkParams{coin.Mainnet, coin.Testnet} {
+		if coin.EVM && params.ChainID == 0 {
+			return ErrInvalidCoin
+		}
+		if !coin.EVM && (len(params.PubKeyHash) == 0 || len(params.ScriptHash) == 0 || params.WIF == nil) {
+			return ErrInvalidCoin
+		}
+		if coin.Taproot && params.HRP == "" {
+			return ErrInvalidCoin
+		}
+		if _, err := params.hdVersions(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// hdVersions decodes the BIP32 extended key version bytes of params.
+func (params *NetworkParams) hdVersions() (bip32.Versions, error) {
+	var versions bip32.Versions
+	for _, v := range []struct {
+		hex string
+		dst *[4]byte
+	}{{params.XPrv, &versions.Private}, {params.XPub, &versions.Public}} {
+		b, err := hex.DecodeString(v.hex)
+		if err != nil || len(b) != len(v.dst) {
+			return versions, ErrInvalidCoin
+		}
+		copy(v.dst[:], b)
+	}
+	return versions, nil
+}
+
+// Network holds the parameters of a coin on either its main or
+// test network.
+type Network struct {
+	// Coin is the lowercase ticker of the coin.
+	Coin    string
+	Testnet bool
+	Params  *chaincfg.Params
+	// HRP is the human-readable part of segwit addresses, empty for
+	// coins without segwit. chaincfg.Params has no room for it.
+	HRP string
+	// PubKeyHashID and ScriptHashID are the version prefixes of base58
+	// addresses. chaincfg.Params holds single version bytes only.
+	PubKeyHashID []byte
+	ScriptHashID []byte
+	// CashAddr is the prefix of CashAddr addresses, empty for coins
+	// without them.
+	CashAddr string
+	// EVM is set for EVM chains, whose ChainID tells them apart.
+	EVM     bool
+	ChainID uint64
+	// Taproot is set for coins with P2TR addresses.
+	Taproot bool
+	// Name and Logo are those of the coin in the registry.
+	Name string
+	Logo string
+}
+
+// NewNetwork returns the network parameters of coin.
+func NewNetwork(ticker string, testnet bool) (*Network, error) {
+	ticker = strings.ToLower(ticker)
+	coin, supported := coins[ticker]
+	if !supported {
+		return nil, ErrUnsupportedCoin
+	}
+	params := coin.Mainnet
+	if testnet {
+		params = coin.Testnet
+	}
+	versions, err := params.hdVersions()
+	if err != nil {
+		return nil, err
+	}
+	net := &Network{
+		Coin:    ticker,
+		Testnet: testnet,
+		Params: &chaincfg.Params{
+			HDPrivateKeyID: versions.Private,
+			HDPublicKeyID:  versions.Public,
+			HDCoinType:     params.CoinType,
+		},
+		HRP:          params.HRP,
+		PubKeyHashID: params.PubKeyHash,
+		ScriptHashID: params.ScriptHash,
+		CashAddr:     params.CashAddr,
+		EVM:          coin.EVM,
+		ChainID:      params.ChainID,
+		Taproot:      coin.Taproot,
+		Name:         coin.Name,
+		Logo:         coin.Logo,
+	}
+	// EVM chains have no WIF keys.
+	if params.WIF != nil {
+		net.Params.PrivateKeyID = *params.WIF
+	}
+	// Keep chaincfg.Params usable with btcutil addresses when the
+	// prefixes fit.
+	if len(params.PubKeyHash) == 1 && len(params.ScriptHash) == 1 {
+		net.Params.PubKeyHashAddrID = params.PubKeyHash[0]
+		net.Params.ScriptHashAddrID = params.ScriptHash[0]
+	}
+	return net, nil
+}
+
+// Coins returns the tickers of the supported coins in order.
+func Coins() []string {
+	var tickers []string
+	for ticker := range coins {
+		tickers = append(tickers, ticker)
+	}
+	sort.Strings(tickers)
+	return tickers
+}
+
+// CoinName returns the display name of the coin with the given ticker.
+func CoinName(ticker string) (string, error) {
+	coin, supported := coins[strings.ToLower(ticker)]
+	if !supported {
+		return "", ErrUnsupportedCoin
+	}
+	return coin.Name, nil
+}
+
+// HDVersions returns the BIP32 extended key version bytes of net.
+func (net *Network) HDVersions() bip32.Versions {
+	return bip32.Versions{Private: net.Params.HDPrivateKeyID, Public: net.Params.HDPublicKeyID}
+}
@@ -0,0 +1,156 @@
+// Copyright (C) 2014-15 Michail Kargakis
+// This source code is subject to the terms
+// of the MIT License
+
+package wallet
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec"
+)
+
+// golden are the WIF and addresses of the compressed private key 1 on
+// the built-in networks, checked against independent encoders.
+var golden = []struct {
+	coin    string
+	testnet bool
+	taproot bool
+	wif     string
+	p2pkh   string
+	p2wpkh  string
+	// cashAddr is the CashAddr form of the P2PKH address.
+	cashAddr string
+}{
+	{"btc", false, true, "KwDiBf89QgGbjEhKnhXJuH7LrciVrZi3qYjgd9M7rFU73sVHnoWn", "1BgGZ9tcN4rm9KBzDn7KprQz87SZ26SAMH", "bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t4", ""},
+	{"btc", true, true, "cMahea7zqjxrtgAbB7LSGbcQUr1uX1ojuat9jZodMN87JcbXMTcA", "mrCDrCybB6J1vRfbwM5hemdJz73FwDBC8r", "tb1qw508d6qejxtdg4y5r3zarvary0c5xw7kxpjzsx", ""},
+	{"ltc", false, true, "T33ydQRKp4FCW5LCLLUB7deioUMoveiwekdwUwyfRDeGZm76aUjV", "LVuDpNCSSj6pQ7t9Pv6d6sUkLKoqDEVUnJ", "ltc1qw508d6qejxtdg4y5r3zarvary0c5xw7kgmn4n9", ""},
+	{"ltc", true, true, "cMahea7zqjxrtgAbB7LSGbcQUr1uX1ojuat9jZodMN87JcbXMTcA", "mrCDrCybB6J1vRfbwM5hemdJz73FwDBC8r", "tltc1qw508d6qejxtdg4y5r3zarvary0c5xw7klfsuq0", ""},
+	{"doge", false, false, "QNcdLVw8fHkixm6NNyN6nVwxKek4u7qrioRbQmjxac5TVoTtZuot", "DFpN6QqFfUm3gKNaxN6tNcab1FArL9cZLE", "", ""},
+	{"doge", true, false, "cejxntqoC3o8qiC8HG8DrwoNyiRDBrMCEU8QrUVpLKdXsGy8LpTM", "nesRpRaAbTDmZHwmzBkLd2AtF7Z9L9z5S2", "", ""},
+	{"bch", false, false, "KwDiBf89QgGbjEhKnhXJuH7LrciVrZi3qYjgd9M7rFU73sVHnoWn", "1BgGZ9tcN4rm9KBzDn7KprQz87SZ26SAMH", "", "bitcoincash:qp63uahgrxged4z5jswyt5dn5v3lzsem6cy4spdc2h"},
+	{"bch", true, false, "cMahea7zqjxrtgAbB7LSGbcQUr1uX1ojuat9jZodMN87JcbXMTcA", "mrCDrCybB6J1vRfbwM5hemdJz73FwDBC8r", "", "bchtest:qp63uahgrxged4z5jswyt5dn5v3lzsem6cq85x00dt"},
+	{"zec", false, false, "KwDiBf89QgGbjEhKnhXJuH7LrciVrZi3qYjgd9M7rFU73sVHnoWn", "t1UYsZVJkLPeMjxEtACvSxfWuNmddpWfxzs", "", ""},
+	{"zec", true, false, "cMahea7zqjxrtgAbB7LSGbcQUr1uX1ojuat9jZodMN87JcbXMTcA", "tmLPctKo9j49rtCSKpwEBpLBeykiTGomGQs", "", ""},
+	{"dash", false, false, "XBHddvWWiMu3nZhhpTXBQWJMmdz5JNKJD85b9fgKAckCT2coW3Y4", "XmN7PQYWKn5MJFna5fRYgP6mxT2F7xpekE", "", ""},
+	{"dash", true, false, "cMahea7zqjxrtgAbB7LSGbcQUr1uX1ojuat9jZodMN87JcbXMTcA", "yWziQMcwmKjRdzi7eWjwiQX8EjWcd6dSg6", "", ""},
+	{"drk", false, false, "XBHddvWWiMu3nZhhpTXBQWJMmdz5JNKJD85b9fgKAckCT2coW3Y4", "XmN7PQYWKn5MJFna5fRYgP6mxT2F7xpekE", "", ""},
+	{"drk", true, false, "cMahea7zqjxrtgAbB7LSGbcQUr1uX1ojuat9jZodMN87JcbXMTcA", "yWziQMcwmKjRdzi7eWjwiQX8EjWcd6dSg6", "", ""},
+	{"dgb", false, false, "KwDiBf89QgGbjEhKnhXJuH7LrciVrZi3qYjgd9M7rFU73sVHnoWn", "DFpN6QqFfUm3gKNaxN6tNcab1FArL9cZLE", "dgb1qw508d6qejxtdg4y5r3zarvary0c5xw7kmudfnm", ""},
+	{"dgb", true, false, "eaHADzXVU3hvWSN7UFFqDD2iDN2h3HseMDE78P13iYRnXacFLhTH", "stGGcqSupoFABvkuJe5Uvei7RfuQZbHqrK", "dgbt1qw508d6qejxtdg4y5r3zarvary0c5xw7kwk83wk", ""},
+	{"btg", false, false, "KwDiBf89QgGbjEhKnhXJuH7LrciVrZi3qYjgd9M7rFU73sVHnoWn", "GUXByHDZLvU4DnVH9imSFckt3HEQ5cFgE5", "btg1qw508d6qejxtdg4y5r3zarvary0c5xw7k6w057a", ""},
+	{"btg", true, false, "cMahea7zqjxrtgAbB7LSGbcQUr1uX1ojuat9jZodMN87JcbXMTcA", "mrCDrCybB6J1vRfbwM5hemdJz73FwDBC8r", "tbtg1qw508d6qejxtdg4y5r3zarvary0c5xw7kduvadh", ""},
+	{"nmc", false, false, "TdNVv3rvWfukQ9PGYe3kJL2foDARGKorJX8TimN3P8f7h5uGyJzz", "N7FdkoPbHSxKfrSVVbRu3NZtrLqc1oKpAR", "nc1qw508d6qejxtdg4y5r3zarvary0c5xw7kttkktk", ""},
+	{"nmc", true, false, "cMahea7zqjxrtgAbB7LSGbcQUr1uX1ojuat9jZodMN87JcbXMTcA", "mrCDrCybB6J1vRfbwM5hemdJz73FwDBC8r", "tn1qw508d6qejxtdg4y5r3zarvary0c5xw7ku7wsq7", ""},
+}
+
+func TestGolden(t *testing.T) {
+	pk, _ := btcec.PrivKeyFromBytes(btcec.S256(), key1)
+	for _, g := range golden {
+		net, err := NewNetwork(g.coin, g.testnet)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if net.Taproot != g.taproot {
+			t.Errorf("%s testnet %v: taproot %v, want %v", g.coin, g.testnet, net.Taproot, g.taproot)
+		}
+		privKey, err := PrivKeyFromEC(net, &Options{AddressType: P2PKH}, pk)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := privKey.String(); got != g.wif {
+			t.Errorf("%s testnet %v: WIF %s, want %s", g.coin, g.testnet, got, g.wif)
+		}
+		if got := privKey.Address().String(); got != g.p2pkh {
+			t.Errorf("%s testnet %v: P2PKH %s, want %s", g.coin, g.testnet, got, g.p2pkh)
+		}
+		if got := privKey.Address().CashAddr(); got != g.cashAddr {
+			t.Errorf("%s testnet %v: CashAddr %s, want %s", g.coin, g.testnet, got, g.cashAddr)
+		}
+
+		segwit, err := PrivKeyFromEC(net, &Options{AddressType: P2WPKH}, pk)
+		if g.p2wpkh == "" {
+			if err != ErrNoSegwit {
+				t.Errorf("%s testnet %v: P2WPKH %v, want %v", g.coin, g.testnet, err, ErrNoSegwit)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := segwit.Address().String(); got != g.p2wpkh {
+			t.Errorf("%s testnet %v: P2WPKH %s, want %s", g.coin, g.testnet, got, g.p2wpkh)
+		}
+	}
+}
+
+func TestLoadCoins(t *testing.T) {
+	tests := []struct {
+		registry string
+		err      bool
+	}{
+		{`xtn: {name: Test, mainnet: {pubkeyhash: 0, scripthash: 5, wif: 0, xprv: 0488ade4, xpub: 0488b21e}, testnet: {pubkeyhash: 111, scripthash: 196, wif: 239, xprv: 04358394, xpub: 043587cf}}`, false},
+		// Missing WIF version byte.
+		{`xtn: {name: Test, mainnet: {pubkeyhash: 0, scripthash: 5, xprv: 0488ade4, xpub: 0488b21e}, testnet: {pubkeyhash: 111, scripthash: 196, wif: 239, xprv: 04358394, xpub: 043587cf}}`, true},
+		// Taproot without segwit.
+		{`xtn: {name: Test, taproot: true, mainnet: {pubkeyhash: 0, scripthash: 5, wif: 128, xprv: 0488ade4, xpub: 0488b21e}, testnet: {pubkeyhash: 111, scripthash: 196, wif: 239, xprv: 04358394, xpub: 043587cf}}`, true},
+		// EVM chains need no WIF version byte.
+		{`xte: {name: Test, evm: true, mainnet: {chainid: 1, xprv: 0488ade4, xpub: 0488b21e}, testnet: {chainid: 5, xprv: 0488ade4, xpub: 0488b21e}}`, false},
+	}
+	for _, test := range tests {
+		_, err := readCoins(strings.NewReader(test.registry), "")
+		if (err != nil) != test.err {
+			t.Errorf("%s: %v", test.registry, err)
+		}
+	}
+
+	restoreCoins(t)
+	if err := LoadCoins(strings.NewReader(tests[0].registry)); err != nil {
+		t.Fatal(err)
+	}
+	net, err := NewNetwork("xtn", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if net.Params.PrivateKeyID != 0 {
+		t.Errorf("WIF version %d, want 0", net.Params.PrivateKeyID)
+	}
+}
+
+func TestLoadCoinsFile(t *testing.T) {
+	dir := t.TempDir()
+	name := filepath.Join(dir, "coins.yaml")
+	abs := filepath.Join(t.TempDir(), "abs.png")
+	registry := `
+xta: {name: Test, logo: logo.png, mainnet: {pubkeyhash: 0, scripthash: 5, wif: 128, xprv: 0488ade4, xpub: 0488b21e}, testnet: {pubkeyhash: 111, scripthash: 196, wif: 239, xprv: 04358394, xpub: 043587cf}}
+xtb: {name: Test, logo: ` + abs + `, mainnet: {pubkeyhash: 0, scripthash: 5, wif: 128, xprv: 0488ade4, xpub: 0488b21e}, testnet: {pubkeyhash: 111, scripthash: 196, wif: 239, xprv: 04358394, xpub: 043587cf}}
+`
+	if err := ioutil.WriteFile(name, []byte(registry), 0600); err != nil {
+		t.Fatal(err)
+	}
+	restoreCoins(t)
+	if err := LoadCoinsFile(name); err != nil {
+		t.Fatal(err)
+	}
+	for ticker, logo := range map[string]string{"xta": filepath.Join(dir, "logo.png"), "xtb": abs} {
+		net, err := NewNetwork(ticker, false)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if net.Logo != logo {
+			t.Errorf("%s: logo %s, want %s", ticker, net.Logo, logo)
+		}
+	}
+}
+
+// restoreCoins restores the supported coins when the test ends.
+func restoreCoins(t *testing.T) {
+	saved := make(map[string]*Coin, len(coins))
+	for ticker, coin := range coins {
+		saved[ticker] = coin
+	}
+	t.Cleanup(func() { coins = saved })
+}
@@ -0,0 +1,52 @@
+// Copyright (C) 2014-15 Michail Kargakis
+// This source code is subject to the terms
+// of the MIT License
+
+package wallet
+
+import (
+	"encoding/hex"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/kargakis/cryptowallet/descriptor"
+)
+
+// outputDescriptor returns the checksummed output descriptor of
+// addresses of type t paying to script, a key or a multisig
+// expression.
+func outputDescriptor(t AddressType, script string) string {
+	var desc string
+	switch t {
+	case P2WPKH:
+		desc = "wpkh(" + script + ")"
+	case P2SHP2WPKH:
+		desc = "sh(wpkh(" + script + "))"
+	case P2TR:
+		desc = "tr(" + script + ")"
+	case P2SH:
+		desc = "sh(" + script + ")"
+	case P2WSH:
+		desc = "wsh(" + script + ")"
+	case P2SHP2WSH:
+		desc = "sh(wsh(" + script + "))"
+	default:
+		desc = "pkh(" + script + ")"
+	}
+	// Keys and scripts are encoded with descriptor characters only.
+	desc, _ = descriptor.Append(desc)
+	return desc
+}
+
+// pubKeyDescriptor returns the watch-only output descriptor of the
+// address of type t paying to pub. Taproot descriptors take x-only
+// public keys.
+func pubKeyDescriptor(pub *btcec.PublicKey, compressed bool, t AddressType) string {
+	serialized := pub.SerializeUncompressed()
+	if compressed {
+		serialized = pub.SerializeCompressed()
+	}
+	if t == P2TR {
+		serialized = serialized[1:33]
+	}
+	return outputDescriptor(t, hex.EncodeToString(serialized))
+}
@@ -0,0 +1,52 @@
+// Copyright (C) 2014-15 Michail Kargakis
+// This source code is subject to the terms
+// of the MIT License
+
+package wallet
+
+import (
+	"encoding/hex"
+
+	"github.com/btcsuite/btcd/btcec"
+	"golang.org/x/crypto/sha3"
+)
+
+// evmAddress is the address of an account on an EVM chain: the last
+// 20 bytes of the Keccak-256 hash of the uncompressed public key,
+// encoded in hex with the mixed-case checksum of EIP-55.
+type evmAddress struct {
+	encoded string
+	hash    []byte
+}
+
+func newEVMAddress(pub *btcec.PublicKey) *evmAddress {
+	hash := keccak256(pub.SerializeUncompressed()[1:])[12:]
+	return &evmAddress{encoded: checksumHex(hash), hash: hash}
+}
+
+func (a *evmAddress) EncodeAddress() string { return a.encoded }
+func (a *evmAddress) ScriptAddress() []byte { return a.hash }
+func (a *evmAddress) String() string        { return a.encoded }
+
+// checksumHex encodes addr in hex, uppercasing each letter whose
+// nibble in the Keccak-256 hash of the lowercase hex is at least 8.
+func checksumHex(addr []byte) string {
+	encoded := []byte(hex.EncodeToString(addr))
+	hash := keccak256(encoded)
+	for i, c := range encoded {
+		nibble := hash[i/2] >> 4
+		if i%2 == 1 {
+			nibble = hash[i/2] & 0xf
+		}
+		if c >= 'a' && nibble >= 8 {
+			encoded[i] = c - 'a' + 'A'
+		}
+	}
+	return "0x" + string(encoded)
+}
+
+func keccak256(data []byte) []byte {
+	h := sha3.NewLegacyKeccak256()
+	h.Write(data)
+	return h.Sum(nil)
+}
@@ -0,0 +1,59 @@
+// Copyright (C) 2014-15 Michail Kargakis
+// This source code is subject to the terms
+// of the MIT License
+
+package wallet
+
+import (
+	"bytes"
+	"encoding/hex"
+	"strings"
+	"testing"
+)
+
+// eip55 are the checksummed addresses of the EIP-55 specification.
+var eip55 = []string{
+	// All caps
+	"0x52908400098527886E0F7030069857D2E4169EE7",
+	"0x8617E340B3D01FA5F11F306F4090FD50E238070D",
+	// All lower
+	"0xde709f2102306220921060314715629080e2fb77",
+	"0x27b1fdb04752bbc536007a920d24acb045561c26",
+	// Normal
+	"0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed",
+	"0xfB6916095ca1df60bB79Ce92cE3Ea74c37c5d359",
+	"0xdbF03B407c01E7cD3CBea99509d93f8DDDC8C6FB",
+	"0xD1220A0cf47c7B9Be7A2E6BA89F429762e7b9aDb",
+}
+
+func TestChecksumHex(t *testing.T) {
+	for _, addr := range eip55 {
+		b, err := hex.DecodeString(strings.ToLower(addr[2:]))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := checksumHex(b); got != addr {
+			t.Errorf("%s: encoded %s", addr, got)
+		}
+	}
+}
+
+func TestEVMPrivKey(t *testing.T) {
+	eth, err := NewNetwork("eth", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pk, err := NewPrivKey(eth, &Options{Entropy: bytes.NewReader(key1), AddressType: EVM})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := pk.String(), hex.EncodeToString(key1); got != want {
+		t.Errorf("key %s, want %s", got, want)
+	}
+	if got, want := pk.Address().String(), "0x7E5F4552091A69125d5DfCb7b8C2659029395Bdf"; got != want {
+		t.Errorf("address %s, want %s", got, want)
+	}
+	if pk.Descriptor() != "" {
+		t.Errorf("descriptor %s, want none", pk.Descriptor())
+	}
+}
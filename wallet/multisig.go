@@ -0,0 +1,159 @@
+// Copyright (C) 2014-15 Michail Kargakis
+// This source code is subject to the terms
+// of the MIT License
+
+package wallet
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcutil"
+)
+
+// MaxMultisigKeys is the most compressed public keys a multisig
+// script fits within the 520 byte limit of P2SH redeem scripts.
+const MaxMultisigKeys = 15
+
+var (
+	// ErrMultisigThreshold is returned for multisig thresholds that
+	// are out of range.
+	ErrMultisigThreshold = errors.New("wallet: invalid multisig threshold or number of keys")
+
+	// ErrDuplicatePubKey is returned when a public key is given more
+	// than once.
+	ErrDuplicatePubKey = errors.New("wallet: duplicate multisig public key")
+)
+
+// Script opcodes.
+const (
+	op0 = 0x00
+	// OP_1 to OP_16 are opN + n.
+	opN             = 0x50
+	opCheckMultisig = 0xae
+)
+
+// Multisig is an address paying to any threshold of a set of public
+// keys.
+type Multisig struct {
+	threshold int
+	pubKeys   [][]byte
+	script    []byte
+	address   *AddrPubKey
+}
+
+// CheckMultisigAddressType returns an error when net has no multisig
+// addresses of type t.
+func CheckMultisigAddressType(net *Network, t AddressType) error {
+	if net.EVM {
+		// EVM accounts have no scripts.
+		return ErrUnsupportedAddress
+	}
+	switch t {
+	case P2SH:
+	case P2WSH, P2SHP2WSH:
+		if net.HRP == "" {
+			return ErrNoSegwit
+		}
+	default:
+		return ErrUnsupportedAddress
+	}
+	return nil
+}
+
+// NewMultisig returns the multisig address of type t on net paying to
+// any threshold of pubKeys. The keys are sorted as in BIP67 so the
+// address does not depend on their order.
+func NewMultisig(net *Network, t AddressType, threshold int, pubKeys []*btcec.PublicKey) (*Multisig, error) {
+	if err := CheckMultisigAddressType(net, t); err != nil {
+		return nil, err
+	}
+	if threshold < 1 || threshold > len(pubKeys) || len(pubKeys) > MaxMultisigKeys {
+		return nil, ErrMultisigThreshold
+	}
+	m := &Multisig{threshold: threshold}
+	for _, pub := range pubKeys {
+		m.pubKeys = append(m.pubKeys, pub.SerializeCompressed())
+	}
+	sort.Sort(byBytes(m.pubKeys))
+	for i := 1; i < len(m.pubKeys); i++ {
+		if bytes.Equal(m.pubKeys[i-1], m.pubKeys[i]) {
+			return nil, ErrDuplicatePubKey
+		}
+	}
+
+	// OP_m <pubkey>... OP_n OP_CHECKMULTISIG
+	m.script = []byte{byte(opN + threshold)}
+	for _, pub := range m.pubKeys {
+		m.script = append(m.script, byte(len(pub)))
+		m.script = append(m.script, pub...)
+	}
+	m.script = append(m.script, byte(opN+len(m.pubKeys)), opCheckMultisig)
+
+	var addr address
+	var redeemScript []byte
+	var err error
+	switch t {
+	case P2SH:
+		redeemScript = m.script
+		addr = newBase58Address(net.ScriptHashID, btcutil.Hash160(redeemScript))
+	case P2WSH:
+		hash := sha256.Sum256(m.script)
+		addr, err = newWitnessAddress(net.HRP, 0, hash[:])
+	case P2SHP2WSH:
+		redeemScript = witnessScriptHashScript(m.script)
+		addr = newBase58Address(net.ScriptHashID, btcutil.Hash160(redeemScript))
+	}
+	if err != nil {
+		return nil, err
+	}
+	if m.address, err = newAddrPubKey(net, addr, t, redeemScript); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// Address returns the multisig address.
+func (m *Multisig) Address() *AddrPubKey { return m.address }
+
+// Threshold returns the number of keys needed to spend.
+func (m *Multisig) Threshold() int { return m.threshold }
+
+// PubKeys returns the hex encoded public keys in script order.
+func (m *Multisig) PubKeys() []string {
+	keys := make([]string, len(m.pubKeys))
+	for i, pub := range m.pubKeys {
+		keys[i] = hex.EncodeToString(pub)
+	}
+	return keys
+}
+
+// Script returns the hex encoded multisig script, the redeem script
+// of P2SH and the witness script of P2WSH addresses.
+func (m *Multisig) Script() string { return hex.EncodeToString(m.script) }
+
+// Descriptor returns the output descriptor of the multisig address.
+func (m *Multisig) Descriptor() string {
+	return outputDescriptor(m.address.Type(), fmt.Sprintf("sortedmulti(%d,%s)", m.threshold, strings.Join(m.PubKeys(), ",")))
+}
+
+// witnessScriptHashScript returns the version 0 witness program
+// paying to the hash of script. Nested in P2SH it is the redeem
+// script of a P2SH-P2WSH address.
+func witnessScriptHashScript(script []byte) []byte {
+	// OP_0 <32-byte hash>
+	hash := sha256.Sum256(script)
+	return append([]byte{op0, 0x20}, hash[:]...)
+}
+
+type byBytes [][]byte
+
+func (b byBytes) Len() int           { return len(b) }
+func (b byBytes) Less(i, j int) bool { return bytes.Compare(b[i], b[j]) < 0 }
+func (b byBytes) Swap(i, j int)      { b[i], b[j] = b[j], b[i] }
@@ -0,0 +1,165 @@
+// Copyright (C) 2014-15 Michail Kargakis
+// This source code is subject to the terms
+// of the MIT License
+
+package wallet
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec"
+)
+
+// bip67Keys are the public keys of the first two BIP67 test vectors,
+// in their unsorted order.
+var bip67Keys = [][]string{
+	{
+		"02ff12471208c14bd580709cb2358d98975247d8765f92bc25eab3b2763ed605f8",
+		"02fe6f0a5a297eb38c391581c4413e084773ea23954d93f7753db7dc0adc188b2f",
+	},
+	{
+		"02632b12f4ac5b1d1b72b2a3b508c19172de44f6f46bcee50ba33f3f9291e47ed0",
+		"027735a29bae7780a9755fae7a1c4374c656ac6a69ea9f3697fda61bb99a4f3e77",
+		"02e2cc6bd5f45edd43bebe7cb9b675f0ce9ed3efe613b177588290ad188d11b404",
+	},
+}
+
+func parsePubKeys(t *testing.T, keys []string) []*btcec.PublicKey {
+	var pubKeys []*btcec.PublicKey
+	for _, k := range keys {
+		b, err := hex.DecodeString(k)
+		if err != nil {
+			t.Fatal(err)
+		}
+		pub, err := btcec.ParsePubKey(b, btcec.S256())
+		if err != nil {
+			t.Fatal(err)
+		}
+		pubKeys = append(pubKeys, pub)
+	}
+	return pubKeys
+}
+
+func TestMultisig(t *testing.T) {
+	btc, err := NewNetwork("btc", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		keys         int
+		addressType  AddressType
+		address      string
+		script       string
+		redeemScript string
+		descriptor   string
+	}{
+		{
+			0, P2SH, "39bgKC7RFbpoCRbtD5KEdkYKtNyhpsNa3Z",
+			"522102fe6f0a5a297eb38c391581c4413e084773ea23954d93f7753db7dc0adc188b2f2102ff12471208c14bd580709cb2358d98975247d8765f92bc25eab3b2763ed605f852ae",
+			"522102fe6f0a5a297eb38c391581c4413e084773ea23954d93f7753db7dc0adc188b2f2102ff12471208c14bd580709cb2358d98975247d8765f92bc25eab3b2763ed605f852ae",
+			"sh(sortedmulti(2,02fe6f0a5a297eb38c391581c4413e084773ea23954d93f7753db7dc0adc188b2f,02ff12471208c14bd580709cb2358d98975247d8765f92bc25eab3b2763ed605f8))#a6zgs9s8",
+		},
+		{
+			0, P2WSH, "bc1qknwt9mhqpd7hrjrvpqz57zjqk28xlp2h90te6v22en0m3uctnams3pq5ce",
+			"522102fe6f0a5a297eb38c391581c4413e084773ea23954d93f7753db7dc0adc188b2f2102ff12471208c14bd580709cb2358d98975247d8765f92bc25eab3b2763ed605f852ae",
+			"",
+			"wsh(sortedmulti(2,02fe6f0a5a297eb38c391581c4413e084773ea23954d93f7753db7dc0adc188b2f,02ff12471208c14bd580709cb2358d98975247d8765f92bc25eab3b2763ed605f8))#3207v35q",
+		},
+		{
+			0, P2SHP2WSH, "3BBLivaThSP3C31jzmQJiMWBM7BLndaWfh",
+			"522102fe6f0a5a297eb38c391581c4413e084773ea23954d93f7753db7dc0adc188b2f2102ff12471208c14bd580709cb2358d98975247d8765f92bc25eab3b2763ed605f852ae",
+			"0020b4dcb2eee00b7d71c86c08054f0a40b28e6f85572bd79d314accdfb8f30b9f77",
+			"sh(wsh(sortedmulti(2,02fe6f0a5a297eb38c391581c4413e084773ea23954d93f7753db7dc0adc188b2f,02ff12471208c14bd580709cb2358d98975247d8765f92bc25eab3b2763ed605f8)))#xtve7999",
+		},
+		{
+			1, P2SH, "3CKHTjBKxCARLzwABMu9yD85kvtm7WnMfH",
+			"522102632b12f4ac5b1d1b72b2a3b508c19172de44f6f46bcee50ba33f3f9291e47ed021027735a29bae7780a9755fae7a1c4374c656ac6a69ea9f3697fda61bb99a4f3e772102e2cc6bd5f45edd43bebe7cb9b675f0ce9ed3efe613b177588290ad188d11b40453ae",
+			"522102632b12f4ac5b1d1b72b2a3b508c19172de44f6f46bcee50ba33f3f9291e47ed021027735a29bae7780a9755fae7a1c4374c656ac6a69ea9f3697fda61bb99a4f3e772102e2cc6bd5f45edd43bebe7cb9b675f0ce9ed3efe613b177588290ad188d11b40453ae",
+			"sh(sortedmulti(2,02632b12f4ac5b1d1b72b2a3b508c19172de44f6f46bcee50ba33f3f9291e47ed0,027735a29bae7780a9755fae7a1c4374c656ac6a69ea9f3697fda61bb99a4f3e77,02e2cc6bd5f45edd43bebe7cb9b675f0ce9ed3efe613b177588290ad188d11b404))#70vu2tvf",
+		},
+		{
+			1, P2WSH, "bc1qud6dmdcc27eg8s5hsy6a075gs49w65l6xtc4cplp6m2d4ggh43wqew2vqs",
+			"522102632b12f4ac5b1d1b72b2a3b508c19172de44f6f46bcee50ba33f3f9291e47ed021027735a29bae7780a9755fae7a1c4374c656ac6a69ea9f3697fda61bb99a4f3e772102e2cc6bd5f45edd43bebe7cb9b675f0ce9ed3efe613b177588290ad188d11b40453ae",
+			"",
+			"wsh(sortedmulti(2,02632b12f4ac5b1d1b72b2a3b508c19172de44f6f46bcee50ba33f3f9291e47ed0,027735a29bae7780a9755fae7a1c4374c656ac6a69ea9f3697fda61bb99a4f3e77,02e2cc6bd5f45edd43bebe7cb9b675f0ce9ed3efe613b177588290ad188d11b404))#3n47papa",
+		},
+		{
+			1, P2SHP2WSH, "31iXMTVFX7qKnPnGVx2ZmJYWuNy3BiCNHS",
+			"522102632b12f4ac5b1d1b72b2a3b508c19172de44f6f46bcee50ba33f3f9291e47ed021027735a29bae7780a9755fae7a1c4374c656ac6a69ea9f3697fda61bb99a4f3e772102e2cc6bd5f45edd43bebe7cb9b675f0ce9ed3efe613b177588290ad188d11b40453ae",
+			"0020e374ddb71857b283c2978135d7fa88854aed53fa32f15c07e1d6d4daa117ac5c",
+			"sh(wsh(sortedmulti(2,02632b12f4ac5b1d1b72b2a3b508c19172de44f6f46bcee50ba33f3f9291e47ed0,027735a29bae7780a9755fae7a1c4374c656ac6a69ea9f3697fda61bb99a4f3e77,02e2cc6bd5f45edd43bebe7cb9b675f0ce9ed3efe613b177588290ad188d11b404)))#dvdpnz9s",
+		},
+	}
+	for _, test := range tests {
+		pubKeys := parsePubKeys(t, bip67Keys[test.keys])
+		// The order of the keys does not matter.
+		reversed := make([]*btcec.PublicKey, len(pubKeys))
+		for i, pub := range pubKeys {
+			reversed[len(pubKeys)-1-i] = pub
+		}
+		for _, keys := range [][]*btcec.PublicKey{pubKeys, reversed} {
+			m, err := NewMultisig(btc, test.addressType, 2, keys)
+			if err != nil {
+				t.Fatalf("%s: %v", test.address, err)
+			}
+			if got := m.Address().String(); got != test.address {
+				t.Errorf("%s: address %s", test.address, got)
+			}
+			if got := m.Script(); got != test.script {
+				t.Errorf("%s: script %s, want %s", test.address, got, test.script)
+			}
+			if got := m.Address().RedeemScript(); got != test.redeemScript {
+				t.Errorf("%s: redeem script %s, want %s", test.address, got, test.redeemScript)
+			}
+			if got := m.Descriptor(); got != test.descriptor {
+				t.Errorf("%s: descriptor %s, want %s", test.address, got, test.descriptor)
+			}
+		}
+	}
+}
+
+func TestMultisigInvalid(t *testing.T) {
+	btc, err := NewNetwork("btc", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	doge, err := NewNetwork("doge", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	eth, err := NewNetwork("eth", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pubKeys := parsePubKeys(t, bip67Keys[1])
+	var many []*btcec.PublicKey
+	for i := 1; i <= MaxMultisigKeys+1; i++ {
+		pk, _ := btcec.PrivKeyFromBytes(btcec.S256(), []byte{byte(i)})
+		many = append(many, pk.PubKey())
+	}
+	tests := []struct {
+		name        string
+		net         *Network
+		addressType AddressType
+		threshold   int
+		pubKeys     []*btcec.PublicKey
+		err         error
+	}{
+		{"duplicate key", btc, P2SH, 2, append(pubKeys[:2:2], pubKeys[0]), ErrDuplicatePubKey},
+		{"m > n", btc, P2SH, 4, pubKeys, ErrMultisigThreshold},
+		{"m = 0", btc, P2WSH, 0, pubKeys, ErrMultisigThreshold},
+		{"n > max", btc, P2SH, 2, many, ErrMultisigThreshold},
+		{"no segwit", doge, P2WSH, 2, pubKeys, ErrNoSegwit},
+		{"evm", eth, P2SH, 2, pubKeys, ErrUnsupportedAddress},
+		{"single key type", btc, P2PKH, 2, pubKeys, ErrUnsupportedAddress},
+	}
+	for _, test := range tests {
+		if _, err := NewMultisig(test.net, test.addressType, test.threshold, test.pubKeys); err != test.err {
+			t.Errorf("%s: error %v, want %v", test.name, err, test.err)
+		}
+	}
+	if _, err := NewMultisig(btc, P2SH, MaxMultisigKeys, many[:MaxMultisigKeys]); err != nil {
+		t.Errorf("%d of %d: %v", MaxMultisigKeys, MaxMultisigKeys, err)
+	}
+}
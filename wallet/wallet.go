@@ -0,0 +1,523 @@
+// Copyright (C) 2014-15 Michail Kargakis
+// This source code is subject to the terms
+// of the MIT License
+
+// Package wallet generates cryptocoin private keys and derives their
+// public addresses, both in text and QR code format.
+package wallet
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"image"
+	"io"
+	"math/big"
+	"strings"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcutil"
+	"github.com/kargakis/cryptowallet/bip32"
+	"github.com/kargakis/cryptowallet/bip38"
+	"github.com/kargakis/cryptowallet/bip39"
+	"github.com/kargakis/cryptowallet/cashaddr"
+	"github.com/kargakis/cryptowallet/keystore"
+	"github.com/kargakis/cryptowallet/taproot"
+
+	"rsc.io/qr"
+)
+
+var (
+	// ErrEntropy is returned when the entropy source runs out before
+	// an unusable key can be replaced.
+	ErrEntropy = errors.New("wallet: not enough entropy for a valid key")
+
+	// ErrNoKeystore is returned for keystores of coins other than EVM
+	// chains.
+	ErrNoKeystore = errors.New("wallet: keystores hold keys of EVM chains only")
+
+	// ErrOptions is returned for options asking for ways of
+	// generating or encrypting a key that do not go together.
+	ErrOptions = errors.New("wallet: incompatible key options")
+)
+
+// Options control how private keys are generated.
+type Options struct {
+	// Entropy is the source of key material. Keys are read from
+	// crypto/rand when nil.
+	Entropy io.Reader
+
+	// AddressType is the type of address paying to the key.
+	AddressType AddressType
+	// Uncompressed selects a legacy uncompressed public key.
+	Uncompressed bool
+
+	// Mnemonic derives the key from a new BIP39 mnemonic of Words
+	// words, protected by the optional Passphrase, at the BIP32
+	// Path. An empty Path selects DefaultPath. Mnemonic keys are
+	// compressed and cannot be BIP38 encrypted, as the mnemonic
+	// would give them away.
+	Mnemonic   bool
+	Words      int
+	Passphrase string
+	Path       string
+
+	// BIP38Passphrase encrypts the key as specified in BIP38.
+	BIP38Passphrase string
+	// IntermediateCode generates a BIP38 encrypted key from a
+	// passphrase intermediate code. The private key itself is never
+	// known, only its public key, so it cannot be encrypted again.
+	IntermediateCode string
+
+	// KeystorePassword also encrypts keys of EVM chains in a Web3
+	// Secret Storage keystore, with a key derived as KeystoreParams
+	// call for, keystore.Standard when nil.
+	KeystorePassword string
+	KeystoreParams   *keystore.Params
+}
+
+// PrivKey is the private key of a cryptocoin public address
+// in WIF, or hex for EVM chains, and QR code format.
+type PrivKey struct {
+	qrCode    *qr.Code
+	value     *btcutil.WIF
+	address   *AddrPubKey
+	mnemonic  string
+	path      string
+	protected bool
+	xpub      string
+	encrypted string
+	// Keys of EVM chains are printed in hex rather than WIF, and
+	// optionally in a keystore.
+	evm      bool
+	keystore string
+	// descriptor holds the WIF of unencrypted keys and the public
+	// key of encrypted ones.
+	descriptor string
+	// Keys generated from a BIP38 intermediate code are only known
+	// by their public key and confirmation code.
+	confirmation string
+}
+
+// QR returns the QR code of a private key.
+func (pk *PrivKey) QR() image.Image { return QRImage(pk.qrCode) }
+
+// String returns the private key in WIF, in hex for EVM chains or,
+// when encrypted, in BIP38 format.
+func (pk *PrivKey) String() string {
+	if pk.encrypted != "" {
+		return pk.encrypted
+	}
+	if pk.evm {
+		return hex.EncodeToString(pk.value.PrivKey.Serialize())
+	}
+	return pk.value.String()
+}
+
+// ECPrivKey returns the private key or nil for a key generated from a
+// BIP38 intermediate code, which is never known.
+func (pk *PrivKey) ECPrivKey() *btcec.PrivateKey {
+	if pk.value == nil {
+		return nil
+	}
+	return pk.value.PrivKey
+}
+
+// Keystore returns the Web3 Secret Storage keystore JSON of the key or
+// an empty string when none was requested.
+func (pk *PrivKey) Keystore() string { return pk.keystore }
+
+// Encrypted reports whether the private key is BIP38 encrypted.
+func (pk *PrivKey) Encrypted() bool { return pk.encrypted != "" }
+
+// Confirmation returns the BIP38 confirmation code of a key generated
+// from an intermediate code or an empty string for other keys.
+func (pk *PrivKey) Confirmation() string { return pk.confirmation }
+
+// Address returns the public address of the private key.
+func (pk *PrivKey) Address() *AddrPubKey { return pk.address }
+
+// Mnemonic returns the BIP39 mnemonic the private key was
+// derived from or an empty string for a random key.
+func (pk *PrivKey) Mnemonic() string { return pk.mnemonic }
+
+// PassphraseProtected reports whether the mnemonic of the private
+// key is protected by a BIP39 passphrase.
+func (pk *PrivKey) PassphraseProtected() bool { return pk.protected }
+
+// Path returns the BIP32 derivation path of the private key or an
+// empty string for a random key.
+func (pk *PrivKey) Path() string { return pk.path }
+
+// Descriptor returns the output descriptor of the address of pk or
+// an empty string for keys of EVM chains, which have no scripts.
+// Encrypted keys have watch-only descriptors of their public key.
+func (pk *PrivKey) Descriptor() string { return pk.descriptor }
+
+// XPub returns the extended public key of the account the private
+// key belongs to or an empty string for a random key.
+func (pk *PrivKey) XPub() string { return pk.xpub }
+
+// AddrPubKey is a cryptocoin public address of a private key
+// in pay-to-pubkey, pay-to-script-hash, segwit or EVM and QR code
+// format.
+type AddrPubKey struct {
+	qrCode       *qr.Code
+	value        address
+	cashAddr     string
+	addrType     AddressType
+	redeemScript []byte
+}
+
+// QR returns the QR code of a public address.
+func (a *AddrPubKey) QR() image.Image { return QRImage(a.qrCode) }
+func (a *AddrPubKey) String() string  { return a.value.EncodeAddress() }
+
+// CashAddr returns the address in CashAddr format or an empty string
+// for coins without CashAddr addresses.
+func (a *AddrPubKey) CashAddr() string { return a.cashAddr }
+
+// Type returns the type of the address.
+func (a *AddrPubKey) Type() AddressType { return a.addrType }
+
+// RedeemScript returns the hex encoded redeem script of a
+// pay-to-script-hash address or an empty string for other types.
+func (a *AddrPubKey) RedeemScript() string { return hex.EncodeToString(a.redeemScript) }
+
+// NewPrivKey returns a new private key of net in WIF and QR code
+// format, generated as opts call for. It returns ErrOptions rather
+// than ignore options that do not apply.
+func NewPrivKey(net *Network, opts *Options) (*PrivKey, error) {
+	if err := CheckAddressType(net, opts.AddressType, !opts.Uncompressed); err != nil {
+		return nil, err
+	}
+	if err := opts.check(net); err != nil {
+		return nil, err
+	}
+	if opts.Mnemonic {
+		return newMnemonicPrivKey(net, opts)
+	}
+	if opts.IntermediateCode != "" {
+		return newIntermediatePrivKey(net, opts)
+	}
+	// Generate new private key
+	pk, err := NewECPrivKey(opts.entropy())
+	if err != nil {
+		return nil, err
+	}
+	return PrivKeyFromEC(net, opts, pk)
+}
+
+// PrivKeyFromEC returns the private key pk of net in WIF and QR code
+// format, encrypted if opts call for it. Options controlling how keys
+// are generated are ignored.
+func PrivKeyFromEC(net *Network, opts *Options, pk *btcec.PrivateKey) (*PrivKey, error) {
+	if opts.BIP38Passphrase != "" {
+		if net.EVM {
+			return nil, ErrOptions
+		}
+		return newEncryptedPrivKey(net, opts, pk)
+	}
+	privKey, err := newPrivKey(net, opts.AddressType, pk, !opts.Uncompressed)
+	if err != nil {
+		return nil, err
+	}
+	if err := privKey.addKeystore(opts); err != nil {
+		return nil, err
+	}
+	return privKey, nil
+}
+
+// addKeystore encrypts the key of pk in a keystore when opts call for
+// it.
+func (pk *PrivKey) addKeystore(opts *Options) error {
+	if opts.KeystorePassword == "" {
+		return nil
+	}
+	if !pk.evm {
+		return ErrNoKeystore
+	}
+	params := opts.KeystoreParams
+	if params == nil {
+		params = keystore.Standard
+	}
+	ks, err := keystore.Encrypt(pk.value.PrivKey.Serialize(), pk.address.value.ScriptAddress(), opts.KeystorePassword, params)
+	if err != nil {
+		return err
+	}
+	pk.keystore = string(ks)
+	return nil
+}
+
+// check returns ErrOptions when opts ask for a key of net that cannot
+// be generated as requested.
+func (opts *Options) check(net *Network) error {
+	switch {
+	case opts.Mnemonic && (opts.Uncompressed || opts.BIP38Passphrase != "" || opts.IntermediateCode != ""):
+		return ErrOptions
+	case !opts.Mnemonic && opts.Passphrase != "":
+		return ErrOptions
+	case opts.IntermediateCode != "" && (opts.BIP38Passphrase != "" || opts.KeystorePassword != ""):
+		return ErrOptions
+	case net.EVM && (opts.BIP38Passphrase != "" || opts.IntermediateCode != ""):
+		// BIP38 encrypts keys of base58 addresses only.
+		return ErrOptions
+	}
+	return nil
+}
+
+func (opts *Options) entropy() io.Reader {
+	if opts.Entropy == nil {
+		return rand.Reader
+	}
+	return opts.Entropy
+}
+
+// readEntropy fills b from r. A short read means the keys r yields
+// so far were unusable.
+func readEntropy(r io.Reader, b []byte) error {
+	_, err := io.ReadFull(r, b)
+	if err == io.EOF || err == io.ErrUnexpectedEOF {
+		return ErrEntropy
+	}
+	return err
+}
+
+// NewECPrivKey reads a private key from r, skipping the negligible
+// chance of values outside the range of the curve order.
+func NewECPrivKey(r io.Reader) (*btcec.PrivateKey, error) {
+	b := make([]byte, 32)
+	for {
+		if err := readEntropy(r, b); err != nil {
+			return nil, err
+		}
+		d := new(big.Int).SetBytes(b)
+		if d.Sign() > 0 && d.Cmp(btcec.S256().N) < 0 {
+			pk, _ := btcec.PrivKeyFromBytes(btcec.S256(), b)
+			return pk, nil
+		}
+	}
+}
+
+// newEncryptedPrivKey encrypts pk with the BIP38 passphrase. The QR
+// code holds the encrypted key instead of the WIF.
+func newEncryptedPrivKey(net *Network, opts *Options, pk *btcec.PrivateKey) (*PrivKey, error) {
+	privKey, err := newPrivKey(net, opts.AddressType, pk, !opts.Uncompressed)
+	if err != nil {
+		return nil, err
+	}
+	encrypted, err := bip38.Encrypt(pk, privKey.value.CompressPubKey, opts.BIP38Passphrase, net.PubKeyHashID)
+	if err != nil {
+		return nil, err
+	}
+	if privKey.qrCode, err = qr.Encode(encrypted, qr.H); err != nil {
+		return nil, err
+	}
+	privKey.encrypted = encrypted
+	privKey.descriptor = pubKeyDescriptor(pk.PubKey(), !opts.Uncompressed, opts.AddressType)
+	return privKey, nil
+}
+
+// newIntermediatePrivKey generates a BIP38 encrypted private key
+// from a passphrase intermediate code.
+func newIntermediatePrivKey(net *Network, opts *Options) (*PrivKey, error) {
+	key, err := bip38.GenerateEncryptedKey(opts.IntermediateCode, !opts.Uncompressed, net.PubKeyHashID)
+	if err != nil {
+		return nil, err
+	}
+	pkCode, err := qr.Encode(key.Encrypted, qr.H)
+	if err != nil {
+		return nil, err
+	}
+	addr, err := NewPubKeyAddress(key.PubKey, key.Compressed, net, opts.AddressType)
+	if err != nil {
+		return nil, err
+	}
+	return &PrivKey{
+		qrCode:       pkCode,
+		address:      addr,
+		encrypted:    key.Encrypted,
+		descriptor:   pubKeyDescriptor(key.PubKey, key.Compressed, opts.AddressType),
+		confirmation: key.Confirmation,
+	}, nil
+}
+
+func newPrivKey(net *Network, t AddressType, pk *btcec.PrivateKey, compressed bool) (*PrivKey, error) {
+	wif, err := btcutil.NewWIF(pk, net.Params, compressed)
+	if err != nil {
+		return nil, err
+	}
+	addr, err := NewAddress(wif, net, t)
+	if err != nil {
+		return nil, err
+	}
+	privKey := &PrivKey{value: wif, address: addr, evm: net.EVM}
+	if !net.EVM {
+		privKey.descriptor = outputDescriptor(t, wif.String())
+	}
+	if privKey.qrCode, err = qr.Encode(privKey.String(), qr.H); err != nil {
+		return nil, err
+	}
+	return privKey, nil
+}
+
+// newMnemonicPrivKey generates a BIP39 mnemonic and returns the
+// private key found at the requested BIP32 path of its seed.
+func newMnemonicPrivKey(net *Network, opts *Options) (*PrivKey, error) {
+	bitSize, err := bip39.EntropyBits(opts.Words)
+	if err != nil {
+		return nil, err
+	}
+	path := opts.Path
+	if path == "" {
+		path = DefaultPath(net, opts.AddressType)
+	}
+	for {
+		entropy := make([]byte, bitSize/8)
+		if err := readEntropy(opts.entropy(), entropy); err != nil {
+			return nil, err
+		}
+		mnemonic, err := bip39.NewMnemonic(entropy)
+		if err != nil {
+			return nil, err
+		}
+		seed := bip39.NewSeed(mnemonic, opts.Passphrase)
+		account, key, err := deriveKey(seed, path, net.HDVersions())
+		if err == bip32.ErrInvalidSeed || err == bip32.ErrInvalidChild {
+			// Unusable seed, BIP32 requires starting over.
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		pk, err := key.ECPrivKey()
+		if err != nil {
+			return nil, err
+		}
+		privKey, err := newPrivKey(net, opts.AddressType, pk, true)
+		if err != nil {
+			return nil, err
+		}
+		privKey.mnemonic = mnemonic
+		privKey.path = path
+		privKey.protected = opts.Passphrase != ""
+		privKey.xpub = account.Neuter().String()
+		if err := privKey.addKeystore(opts); err != nil {
+			return nil, err
+		}
+		return privKey, nil
+	}
+}
+
+// deriveKey derives the extended key at path from seed along with
+// its account, the deepest hardened key on the path, whose extended
+// public key is what watch-only wallets import.
+func deriveKey(seed []byte, path string, versions bip32.Versions) (account, key *bip32.ExtendedKey, err error) {
+	indexes, err := bip32.ParsePath(path)
+	if err != nil {
+		return nil, nil, err
+	}
+	key, err = bip32.NewMaster(seed, versions)
+	if err != nil {
+		return nil, nil, err
+	}
+	account = key
+	for _, i := range indexes {
+		if key, err = key.Child(i); err != nil {
+			return nil, nil, err
+		}
+		if i >= bip32.HardenedKeyStart {
+			account = key
+		}
+	}
+	return account, key, nil
+}
+
+// NewAddress returns a new public address of type t on net derived
+// from the passed private key.
+func NewAddress(pk *btcutil.WIF, net *Network, t AddressType) (*AddrPubKey, error) {
+	// Extract public from private key and create a new address
+	// in the format the WIF calls for
+	return NewPubKeyAddress(pk.PrivKey.PubKey(), pk.CompressPubKey, net, t)
+}
+
+// NewPubKeyAddress returns a new public address of type t on net
+// for the passed public key.
+func NewPubKeyAddress(pub *btcec.PublicKey, compressed bool, net *Network, t AddressType) (*AddrPubKey, error) {
+	addr, redeemScript, err := pubKeyAddress(pub, compressed, net, t)
+	if err != nil {
+		return nil, err
+	}
+	return newAddrPubKey(net, addr, t, redeemScript)
+}
+
+// newAddrPubKey returns addr along with its QR code. Base58 addresses
+// of coins with CashAddr addresses are also encoded as CashAddr, which
+// the QR code holds.
+func newAddrPubKey(net *Network, addr address, t AddressType, redeemScript []byte) (*AddrPubKey, error) {
+	a := &AddrPubKey{value: addr, addrType: t, redeemScript: redeemScript}
+	text := qrText(addr)
+	var err error
+	if b, ok := addr.(*base58Address); ok && net.CashAddr != "" {
+		typ := cashaddr.P2SH
+		if t == P2PKH {
+			typ = cashaddr.P2PKH
+		}
+		if a.cashAddr, err = cashaddr.Encode(net.CashAddr, typ, b.hash); err != nil {
+			return nil, err
+		}
+		// Uppercase fits the more compact alphanumeric QR mode.
+		text = strings.ToUpper(a.cashAddr)
+	}
+	if a.qrCode, err = qr.Encode(text, qr.H); err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+// EncodeAddress returns the public address of type t on net for the
+// passed public key as a string. Unlike NewPubKeyAddress, it skips
+// the QR code, for callers deriving many addresses.
+func EncodeAddress(pub *btcec.PublicKey, compressed bool, net *Network, t AddressType) (string, error) {
+	addr, _, err := pubKeyAddress(pub, compressed, net, t)
+	if err != nil {
+		return "", err
+	}
+	return addr.EncodeAddress(), nil
+}
+
+// pubKeyAddress returns the address of type t for pub along with
+// its redeem script, if any.
+func pubKeyAddress(pub *btcec.PublicKey, compressed bool, net *Network, t AddressType) (address, []byte, error) {
+	if err := CheckAddressType(net, t, compressed); err != nil {
+		return nil, nil, err
+	}
+	serialized := pub.SerializeUncompressed()
+	if compressed {
+		serialized = pub.SerializeCompressed()
+	}
+	var addr address
+	var redeemScript []byte
+	var err error
+	switch t {
+	case P2WPKH:
+		addr, err = newWitnessAddress(net.HRP, 0, btcutil.Hash160(serialized))
+	case P2SHP2WPKH:
+		redeemScript = witnessPubKeyHashScript(serialized)
+		addr = newBase58Address(net.ScriptHashID, btcutil.Hash160(redeemScript))
+	case P2TR:
+		var outputKey []byte
+		if outputKey, err = taproot.OutputKey(pub); err != nil {
+			return nil, nil, err
+		}
+		addr, err = newWitnessAddress(net.HRP, 1, outputKey)
+	case EVM:
+		addr = newEVMAddress(pub)
+	default:
+		addr = newBase58Address(net.PubKeyHashID, btcutil.Hash160(serialized))
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	return addr, redeemScript, nil
+}
@@ -0,0 +1,111 @@
+// Copyright (C) 2014-15 Michail Kargakis
+// This source code is subject to the terms
+// of the MIT License
+
+package wallet
+
+import (
+	"bytes"
+	"testing"
+)
+
+// key1 is the private key 1, whose keys and addresses are well known.
+var key1 = append(make([]byte, 31), 1)
+
+func TestPrivKeyWIF(t *testing.T) {
+	net, err := NewNetwork("btc", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		uncompressed bool
+		wif, address string
+	}{
+		{false, "KwDiBf89QgGbjEhKnhXJuH7LrciVrZi3qYjgd9M7rFU73sVHnoWn", "1BgGZ9tcN4rm9KBzDn7KprQz87SZ26SAMH"},
+		{true, "5HpHagT65TZzG1PH3CSu63k8DbpvD8s5ip4nEB3kEsreAnchuDf", "1EHNa6Q4Jz2uvNExL497mE43ikXhwF6kZm"},
+	}
+	for _, test := range tests {
+		opts := &Options{
+			Entropy:      bytes.NewReader(key1),
+			AddressType:  P2PKH,
+			Uncompressed: test.uncompressed,
+		}
+		pk, err := NewPrivKey(net, opts)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := pk.String(); got != test.wif {
+			t.Errorf("uncompressed %v: WIF %s, want %s", test.uncompressed, got, test.wif)
+		}
+		if got := pk.Address().String(); got != test.address {
+			t.Errorf("uncompressed %v: address %s, want %s", test.uncompressed, got, test.address)
+		}
+	}
+}
+
+func TestDecodeWIF(t *testing.T) {
+	net, err := NewNetwork("btc", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, uncompressed := range []bool{false, true} {
+		opts := &Options{AddressType: P2PKH, Uncompressed: uncompressed}
+		pk, err := NewPrivKey(net, opts)
+		if err != nil {
+			t.Fatal(err)
+		}
+		wif, nets, err := DecodeWIF(pk.String())
+		if err != nil {
+			t.Fatalf("uncompressed %v: %v", uncompressed, err)
+		}
+		if wif.CompressPubKey == uncompressed {
+			t.Errorf("uncompressed %v: decoded compression flag %v", uncompressed, wif.CompressPubKey)
+		}
+		found := false
+		for _, n := range nets {
+			if n.Coin == "btc" && !n.Testnet {
+				found = true
+			}
+		}
+		if !found {
+			t.Errorf("uncompressed %v: %s not decoded as a bitcoin key", uncompressed, pk)
+		}
+		addr, err := NewAddress(wif, net, P2PKH)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if addr.String() != pk.Address().String() {
+			t.Errorf("uncompressed %v: decoded address %s, want %s", uncompressed, addr, pk.Address())
+		}
+	}
+}
+
+func TestNewPrivKeyOptions(t *testing.T) {
+	btc, err := NewNetwork("btc", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	eth, err := NewNetwork("eth", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	code := "passphrasepxFy57B9v8HtUsszJYKReoNDV6VHjUSGt8EVJmux9n1J3Ltf1gRxyDGXqnf9qm"
+	tests := []struct {
+		name string
+		net  *Network
+		opts Options
+	}{
+		{"mnemonic bip38", btc, Options{AddressType: P2PKH, Mnemonic: true, Words: 12, BIP38Passphrase: "pass"}},
+		{"mnemonic uncompressed", btc, Options{AddressType: P2PKH, Mnemonic: true, Words: 12, Uncompressed: true}},
+		{"mnemonic intermediate", btc, Options{AddressType: P2PKH, Mnemonic: true, Words: 12, IntermediateCode: code}},
+		{"passphrase without mnemonic", btc, Options{AddressType: P2PKH, Passphrase: "pass"}},
+		{"intermediate bip38", btc, Options{AddressType: P2PKH, IntermediateCode: code, BIP38Passphrase: "pass"}},
+		{"evm bip38", eth, Options{AddressType: EVM, BIP38Passphrase: "pass"}},
+		{"evm intermediate", eth, Options{AddressType: EVM, IntermediateCode: code}},
+	}
+	for _, test := range tests {
+		if _, err := NewPrivKey(test.net, &test.opts); err != ErrOptions {
+			t.Errorf("%s: error %v, want %v", test.name, err, ErrOptions)
+		}
+	}
+}
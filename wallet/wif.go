@@ -0,0 +1,43 @@
+// Copyright (C) 2014-15 Michail Kargakis
+// This source code is subject to the terms
+// of the MIT License
+
+package wallet
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/btcsuite/btcutil"
+)
+
+// ErrUnknownNetwork is returned for private keys of no supported
+// network.
+var ErrUnknownNetwork = errors.New("wallet: private key of unsupported network")
+
+// DecodeWIF decodes a private key in WIF, checking its checksum, and
+// returns it along with the supported networks its version byte
+// belongs to. Testnets share version bytes, so there may be several.
+func DecodeWIF(s string) (*btcutil.WIF, []*Network, error) {
+	wif, err := btcutil.DecodeWIF(strings.TrimSpace(s))
+	if err != nil {
+		return nil, nil, err
+	}
+	var nets []*Network
+	for _, coin := range Coins() {
+		for _, testnet := range []bool{false, true} {
+			net, err := NewNetwork(coin, testnet)
+			if err != nil {
+				return nil, nil, err
+			}
+			// EVM chains have no WIF keys.
+			if !net.EVM && wif.IsForNet(net.Params) {
+				nets = append(nets, net)
+			}
+		}
+	}
+	if len(nets) == 0 {
+		return nil, nil, ErrUnknownNetwork
+	}
+	return wif, nets, nil
+}